@@ -6,8 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/provision"
 	"github.com/urfave/cli/v3"
 )
 
@@ -74,3 +76,305 @@ func CreateNewAppAction(ctx context.Context, cmd *cli.Command) error {
 
 	return nil
 }
+
+func AddAppSendAction(ctx context.Context, cmd *cli.Command) error {
+	return addAppMessage(cmd, "sends")
+}
+
+func RemoveAppSendAction(ctx context.Context, cmd *cli.Command) error {
+	return removeAppMessage(cmd, "sends")
+}
+
+func AddAppReceiveAction(ctx context.Context, cmd *cli.Command) error {
+	return addAppMessage(cmd, "receives")
+}
+
+func RemoveAppReceiveAction(ctx context.Context, cmd *cli.Command) error {
+	return removeAppMessage(cmd, "receives")
+}
+
+// appMessageTarget is an app, message and resource resolved from command flags
+type appMessageTarget struct {
+	App      *api.AppAPIResponse
+	Message  *api.MessageAPIResponse
+	Resource *resolvedResource
+	// Existing is set when the app already sends or receives the message through the resource
+	Existing *api.AppMessageAPIResponse
+}
+
+// resolveAppMessageTarget resolves the --app, --message and --resource flags
+func resolveAppMessageTarget(client *api.FCApiClient, cmd *cli.Command, direction string) (*appMessageTarget, error) {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	appName := cmd.String("app")
+	messageName := cmd.String("message")
+	resourceURI := cmd.String("resource")
+
+	if projectID == "" {
+		return nil, cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if appName == "" {
+		return nil, cli.Exit("App name is required. Please provide it using --app flag", 1)
+	}
+	if messageName == "" {
+		return nil, cli.Exit("Message name is required. Please provide it using --message flag", 1)
+	}
+	if resourceURI == "" {
+		return nil, cli.Exit("Resource is required. Please provide it using --resource flag", 1)
+	}
+
+	app, err := findAppByName(client, projectID, appName)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := findMessageByName(client, projectID, messageName)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := resolveResourceURI(client, projectID, resourceURI)
+	if err != nil {
+		return nil, err
+	}
+	if resource.Resource == nil {
+		return nil, cli.Exit(fmt.Sprintf("Resource %s not found", resource.URI), 1)
+	}
+
+	target := &appMessageTarget{App: app, Message: message, Resource: resource}
+
+	var existing []api.AppMessageAPIResponse
+	if direction == "sends" {
+		existing, err = client.ListAppSends(app.ID)
+	} else {
+		existing, err = client.ListAppReceives(app.ID)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to list app messages: %s", err))
+	}
+	for _, entry := range existing {
+		if entry.MessageID == message.ID && entry.ResourceID == resource.Resource.ID {
+			target.Existing = &entry
+			break
+		}
+	}
+
+	return target, nil
+}
+
+func addAppMessage(cmd *cli.Command, direction string) error {
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	target, err := resolveAppMessageTarget(client, cmd, direction)
+	if err != nil {
+		return err
+	}
+	if target.Existing != nil {
+		return cli.Exit(fmt.Sprintf("App %s already %s %s via %s", target.App.Name, direction, target.Message.Name, target.Resource.URI), 1)
+	}
+
+	var entry *api.AppMessageAPIResponse
+	if direction == "sends" {
+		entry, err = client.AddAppSend(target.App.ID, target.Message.ID, target.Resource.Resource.ID)
+	} else {
+		entry, err = client.AddAppReceive(target.App.ID, target.Message.ID, target.Resource.Resource.ID)
+	}
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to add message to app: %s", err))
+	}
+
+	// Print formatted JSON response
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(entry); err != nil {
+		return errors.New(fmt.Sprintf("failed to encode response: %s", err))
+	}
+
+	return nil
+}
+
+func removeAppMessage(cmd *cli.Command, direction string) error {
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	target, err := resolveAppMessageTarget(client, cmd, direction)
+	if err != nil {
+		return err
+	}
+	if target.Existing == nil {
+		return cli.Exit(fmt.Sprintf("App %s does not %s %s via %s", target.App.Name, strings.TrimSuffix(direction, "s"), target.Message.Name, target.Resource.URI), 1)
+	}
+
+	if direction == "sends" {
+		err = client.RemoveAppSend(target.App.ID, target.Existing.ID)
+	} else {
+		err = client.RemoveAppReceive(target.App.ID, target.Existing.ID)
+	}
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to remove message from app: %s", err))
+	}
+
+	fmt.Printf("App %s no longer %s %s via %s\n", target.App.Name, direction, target.Message.Name, target.Resource.URI)
+	return nil
+}
+
+// appContract is the complete communication contract of an app
+type appContract struct {
+	api.AppAPIResponse
+	Sends    []appContractEntry `json:"sends"`
+	Receives []appContractEntry `json:"receives"`
+}
+
+type appContractEntry struct {
+	Message       string `json:"message"`
+	Schema        string `json:"schema"`
+	SchemaVersion int    `json:"schema_version"`
+	Resource      string `json:"resource"`
+}
+
+func DescribeAppAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	appName := cmd.String("app")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if appName == "" {
+		return cli.Exit("App name is required. Please provide it using --app flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	app, err := findAppByName(client, projectID, appName)
+	if err != nil {
+		return err
+	}
+
+	// Collect everything needed to describe the messages and resources by name
+	messages, err := client.ListMessages(projectID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list messages: %s", err))
+	}
+	messagesByID := map[string]api.MessageAPIResponse{}
+	for _, message := range messages {
+		messagesByID[message.ID] = message
+	}
+
+	schemaList, err := client.ListSchemas(projectID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list schemas: %s", err))
+	}
+	schemaNames := map[string]string{}
+	for _, schema := range schemaList {
+		schemaNames[schema.ID] = schema.Name
+	}
+
+	servers, err := provision.ListProjectServers(client, projectID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list resources: %s", err))
+	}
+	resourceURIs := provision.ResourceURIs(servers)
+
+	describe := func(entries []api.AppMessageAPIResponse) []appContractEntry {
+		contract := []appContractEntry{}
+		for _, entry := range entries {
+			message := messagesByID[entry.MessageID]
+			contract = append(contract, appContractEntry{
+				Message:       message.Name,
+				Schema:        schemaNames[message.SchemaID],
+				SchemaVersion: message.SchemaVersion,
+				Resource:      resourceURIs[entry.ResourceID],
+			})
+		}
+		return contract
+	}
+
+	sends, err := client.ListAppSends(app.ID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list messages sent by app: %s", err))
+	}
+	receives, err := client.ListAppReceives(app.ID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list messages received by app: %s", err))
+	}
+
+	contract := appContract{
+		AppAPIResponse: *app,
+		Sends:          describe(sends),
+		Receives:       describe(receives),
+	}
+
+	// Print formatted JSON
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(contract); err != nil {
+		return errors.New(fmt.Sprintf("failed to encode response: %s", err))
+	}
+
+	return nil
+}
+
+// findAppByName looks up an app in a project by its name
+func findAppByName(client *api.FCApiClient, projectID string, name string) (*api.AppAPIResponse, error) {
+	apps, err := client.ListApps(projectID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to list apps: %s", err))
+	}
+
+	for _, app := range apps {
+		if app.Name == name {
+			return &app, nil
+		}
+	}
+
+	return nil, cli.Exit(fmt.Sprintf("App %q not found in project %s", name, projectID), 1)
+}
+
+// appMessageUsage is a message sent or received by an app through a resource
+type appMessageUsage struct {
+	api.AppMessageAPIResponse
+	App api.AppAPIResponse
+	// Direction is either "sends" or "receives"
+	Direction string
+}
+
+// listAppMessageUsages collects the sends and receives of all apps in a project
+func listAppMessageUsages(client *api.FCApiClient, projectID string) ([]appMessageUsage, error) {
+	apps, err := client.ListApps(projectID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to list apps: %s", err))
+	}
+
+	var usages []appMessageUsage
+	for _, app := range apps {
+		sends, err := client.ListAppSends(app.ID)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to list messages sent by app %s: %s", app.Name, err))
+		}
+		for _, send := range sends {
+			usages = append(usages, appMessageUsage{AppMessageAPIResponse: send, App: app, Direction: "sends"})
+		}
+
+		receives, err := client.ListAppReceives(app.ID)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("failed to list messages received by app %s: %s", app.Name, err))
+		}
+		for _, receive := range receives {
+			usages = append(usages, appMessageUsage{AppMessageAPIResponse: receive, App: app, Direction: "receives"})
+		}
+	}
+
+	return usages, nil
+}
@@ -0,0 +1,132 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/asyncapi"
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/urfave/cli/v3"
+)
+
+func ExportAsyncAPIAction(ctx context.Context, cmd *cli.Command) error {
+	appName := cmd.String("app")
+	version := cmd.String("version")
+	format := cmd.String("format")
+	outputPath := cmd.String("out")
+	if format == "" {
+		format = "yaml"
+	}
+
+	document, err := loadProjectDocument(cmd)
+	if err != nil {
+		return err
+	}
+
+	title := appName
+	if title == "" {
+		if filePath := cmd.String("file"); filePath != "" {
+			title = strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+		} else {
+			title = cmd.String("project-id")
+		}
+	}
+
+	spec, err := asyncapi.Export(document, asyncapi.Options{
+		Title:   title,
+		Version: version,
+		App:     appName,
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to export AsyncAPI document: %v", err), 1)
+	}
+
+	data, err := asyncapi.Marshal(spec, format)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format AsyncAPI document: %v", err), 1)
+	}
+
+	if outputPath == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, data, 0644); err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to write AsyncAPI document: %v", err), 1)
+	}
+
+	fmt.Printf("AsyncAPI document exported to %s\n", outputPath)
+	return nil
+}
+
+func ImportAsyncAPIAction(ctx context.Context, cmd *cli.Command) error {
+	specPath := cmd.Args().First()
+	if specPath == "" {
+		specPath = cmd.String("file")
+	}
+	outputPath := cmd.String("out")
+	apply := cmd.Bool("apply")
+	projectID := cmd.String("project-id")
+
+	if specPath == "" {
+		return cli.Exit("AsyncAPI document is required. Please provide it as an argument or using --file flag", 1)
+	}
+	if apply && projectID == "" {
+		return cli.Exit("Project ID is required with --apply. Please provide it using --project-id flag", 1)
+	}
+
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to read AsyncAPI document: %v", err), 1)
+	}
+
+	document, unsupported, err := asyncapi.Import(data)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to convert AsyncAPI document: %v", err), 1)
+	}
+	if err := validateProvisionDocument(document); err != nil {
+		return err
+	}
+
+	content, err := provision.Marshal(document)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format project definition: %v", err), 1)
+	}
+
+	// Without --out or --apply the project definition goes to stdout, so the report goes to stderr
+	report := os.Stdout
+	if outputPath == "" && !apply {
+		fmt.Print(string(content))
+		report = os.Stderr
+	}
+	if len(unsupported) > 0 {
+		fmt.Fprintf(report, "The following constructs cannot be represented and were skipped:\n  - %s\n", strings.Join(unsupported, "\n  - "))
+	}
+
+	if outputPath != "" {
+		if err := os.WriteFile(outputPath, content, 0644); err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to write project definition: %v", err), 1)
+		}
+		fmt.Printf("Project definition written to %s\n", outputPath)
+	}
+
+	if apply {
+		client, err := api.NewFCApiClient()
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+		}
+		if err := client.ImportProjectDefinition(projectID, content); err != nil {
+			if apiErr, ok := err.(*api.APIError); ok {
+				return cli.Exit(fmt.Sprintf("Failed to import project: %s", apiErr.Error()), 1)
+			}
+			return cli.Exit(fmt.Sprintf("Failed to import project: %v", err.Error()), 1)
+		}
+		fmt.Printf("AsyncAPI document imported into project %s\n", projectID)
+	}
+
+	return nil
+}
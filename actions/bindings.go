@@ -0,0 +1,132 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+func ListBindingsAction(ctx context.Context, cmd *cli.Command) error {
+	serverID := cmd.String("server-id")
+
+	if serverID == "" {
+		return errors.New("Server ID is required")
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	binds, err := client.ListServerBinds(serverID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to list bindings: %v", err))
+	}
+
+	jsonData, err := json.MarshalIndent(binds, "", "  ")
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to format bindings: %v", err))
+	}
+
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+func CreateBindingAction(ctx context.Context, cmd *cli.Command) error {
+	serverID := cmd.String("server-id")
+	source := cmd.String("source")
+	destination := cmd.String("destination")
+	routingKey := cmd.String("routing-key")
+
+	if serverID == "" {
+		return errors.New("Server ID is required")
+	}
+
+	if source == "" {
+		return errors.New("Binding source is required (name of an exchange)")
+	}
+
+	if destination == "" {
+		return errors.New("Binding destination is required (name of a queue)")
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	// Both ends of the binding have to be resources of the same server
+	server, err := client.GetServer(serverID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to get server: %v", err))
+	}
+
+	resources, err := client.ListServerResources(serverID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to list resources: %v", err))
+	}
+
+	resourceTypes := map[string]contracts.ResourceType{}
+	for _, resource := range resources {
+		resourceTypes[resource.Name] = contracts.ResourceType(resource.ResourceType)
+	}
+	if _, ok := resourceTypes[source]; !ok {
+		return errors.New(fmt.Sprintf("Invalid binding: source %q is not a resource of server %q", source, server.Name))
+	}
+	if _, ok := resourceTypes[destination]; !ok {
+		return errors.New(fmt.Sprintf("Invalid binding: destination %q is not a resource of server %q", destination, server.Name))
+	}
+	if err := contracts.ValidateBindForProtocol(server.Protocol, resourceTypes[source], resourceTypes[destination]); err != nil {
+		return errors.New(fmt.Sprintf("Invalid binding: %v", err))
+	}
+
+	bind := contracts.ServerBind{
+		Source:      source,
+		Destination: destination,
+		RoutingKey:  routingKey,
+	}
+
+	newBind, err := client.CreateServerBind(serverID, bind)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to create binding: %v", err))
+	}
+
+	jsonData, err := json.MarshalIndent(newBind, "", "  ")
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to format binding: %v", err))
+	}
+
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+func DeleteBindingAction(ctx context.Context, cmd *cli.Command) error {
+	serverID := cmd.String("server-id")
+	bindID := cmd.String("bind-id")
+
+	if serverID == "" {
+		return errors.New("Server ID is required")
+	}
+
+	if bindID == "" {
+		return errors.New("Binding ID is required")
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	if err := client.DeleteServerBind(serverID, bindID); err != nil {
+		return errors.New(fmt.Sprintf("Failed to delete binding: %v", err))
+	}
+
+	fmt.Printf("Binding %s deleted\n", bindID)
+	return nil
+}
@@ -50,22 +50,35 @@ func GenerateAppCodeAction(ctx context.Context, cmd *cli.Command) error {
 		return errors.New(fmt.Sprintf("failed to generate code: %s", err))
 	}
 
-	// Create fusioncat directory if it doesn't exist
-	if err := os.MkdirAll("fusioncat", 0755); err != nil {
-		return errors.New(fmt.Sprintf("failed to create fusioncat directory: %s", err))
+	filePath, err := writeGeneratedCode(appID, language, code)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Code generated successfully and saved to %s\n", filePath)
+	return nil
+}
+
+// writeGeneratedCode saves the code generated for an app into the code generation output
+// directory and returns the path of the file
+func writeGeneratedCode(appID string, language string, code string) (string, error) {
+	outputDir := codegenOutputDir()
+
+	// Create output directory if it doesn't exist
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return "", errors.New(fmt.Sprintf("failed to create %s directory: %s", outputDir, err))
 	}
 
 	// Generate filename based on app ID and language
 	fileName := fmt.Sprintf("%s.%s", appID, getFileExtension(language))
 
 	// Write the generated code to file
-	filePath := filepath.Join("fusioncat", fileName)
+	filePath := filepath.Join(outputDir, fileName)
 	if err := os.WriteFile(filePath, []byte(code), 0644); err != nil {
-		return errors.New(fmt.Sprintf("failed to write generated code to file: %s", err))
+		return "", errors.New(fmt.Sprintf("failed to write generated code to file: %s", err))
 	}
 
-	fmt.Printf("Code generated successfully and saved to %s\n", filePath)
-	return nil
+	return filePath, nil
 }
 
 // getFileExtension returns the appropriate file extension for the given language
@@ -83,3 +96,38 @@ func getFileExtension(language string) string {
 		return "txt"
 	}
 }
+
+// codegenLanguage returns the code generation language of the settings file in the
+// current directory, or an empty string when there is no settings file
+func codegenLanguage() string {
+	settings := readSettingsFile()
+	if settings == nil {
+		return ""
+	}
+	return settings.CodeGeneration.Language
+}
+
+// codegenOutputDir returns the directory generated code is written to, as configured in the
+// settings file of the current directory
+func codegenOutputDir() string {
+	settings := readSettingsFile()
+	if settings == nil || settings.CodeGeneration.OutputDir == "" {
+		return "fusioncat"
+	}
+	return settings.CodeGeneration.OutputDir
+}
+
+// readSettingsFile reads the settings file of the current directory, it returns nil when
+// there is no readable settings file
+func readSettingsFile() *contracts.SettingYAMLFile {
+	data, err := os.ReadFile("fcsettings.yaml")
+	if err != nil {
+		return nil
+	}
+
+	var settings contracts.SettingYAMLFile
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return nil
+	}
+	return &settings
+}
@@ -0,0 +1,219 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/fusioncatalyst/paw/watch"
+	"github.com/urfave/cli/v3"
+)
+
+// devSession applies a project definition to a sandbox project and regenerates the code of apps
+type devSession struct {
+	client    *api.FCApiClient
+	projectID string
+	filePath  string
+	vars      map[string]string
+	apps      []string
+	language  string
+	// applied is the project definition of the last successful run, unchanged definitions are not applied again
+	applied []byte
+}
+
+func DevAction(ctx context.Context, cmd *cli.Command) error {
+	filePath := cmd.String("file")
+	projectID := cmd.String("project-id")
+	apps := cmd.StringSlice("app")
+	language := cmd.String("language")
+	once := cmd.Bool("once")
+	watcher := watch.Watcher{
+		Interval: cmd.Duration("interval"),
+		Debounce: cmd.Duration("debounce"),
+	}
+	if watcher.Interval <= 0 {
+		watcher.Interval = 500 * time.Millisecond
+	}
+	if watcher.Debounce < 0 {
+		watcher.Debounce = 0
+	}
+
+	if filePath == "" {
+		return cli.Exit("File is required. Please provide it using --file flag", 1)
+	}
+	if projectID == "" {
+		return cli.Exit("Project ID of the sandbox project is required. Please provide it using --project-id flag", 1)
+	}
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return cli.Exit(fmt.Sprintf("File not found: %s", filePath), 1)
+	}
+	if len(apps) > 0 && language == "" {
+		language = codegenLanguage()
+		if language == "" {
+			return cli.Exit("Language is required to generate code. Please provide it using --language flag or fcsettings.yaml", 1)
+		}
+	}
+
+	vars, err := provisionVars(cmd)
+	if err != nil {
+		return err
+	}
+
+	absolutePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to resolve file path: %v", err), 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	session := &devSession{
+		client:    client,
+		projectID: projectID,
+		filePath:  filePath,
+		vars:      vars,
+		apps:      apps,
+		language:  language,
+	}
+
+	if once {
+		started := time.Now()
+		summary, _, err := session.run()
+		if err != nil {
+			return err
+		}
+		printDevStatus(started, summary)
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	files := []string{absolutePath}
+	for first := true; ; first = false {
+		snapshot := watch.Take(files)
+		started := time.Now()
+		summary, sources, err := session.run()
+		if err != nil {
+			printDevStatus(started, err.Error())
+		} else {
+			printDevStatus(started, summary)
+		}
+
+		// Keep watching files which are no longer referenced, they may be referenced again
+		files = appendMissing(files, sources)
+		if first {
+			fmt.Printf("Watching %d files and directories for changes, press Ctrl+C to stop\n", len(files))
+		}
+
+		if _, err := watcher.Wait(ctx, files, snapshot); err != nil {
+			fmt.Println("Stopped watching")
+			return nil
+		}
+	}
+}
+
+// run validates the project definition, applies it to the sandbox project and regenerates
+// the code of the apps. It returns a summary of what was done and the files the definition
+// was loaded from.
+func (s *devSession) run() (string, []string, error) {
+	document, sources, err := provision.LoadWithSources(s.filePath, provision.LoadOptions{Vars: s.vars})
+	if err != nil {
+		return "", sources, cli.Exit(fmt.Sprintf("Failed to load project definition: %v", err), 1)
+	}
+	if err := validateProvisionDocument(document); err != nil {
+		return "", sources, err
+	}
+
+	content, err := provision.Marshal(document)
+	if err != nil {
+		return "", sources, cli.Exit(fmt.Sprintf("Failed to format project definition: %v", err), 1)
+	}
+	if bytes.Equal(content, s.applied) {
+		return "Project definition is valid, nothing changed", sources, nil
+	}
+
+	if err := s.client.ImportProjectDefinition(s.projectID, content); err != nil {
+		return "", sources, cli.Exit(fmt.Sprintf("Failed to import project: %v", err), 1)
+	}
+
+	if err := s.generate(); err != nil {
+		return "", sources, cli.Exit(fmt.Sprintf("Failed to generate code: %v", err), 1)
+	}
+	s.applied = content
+
+	summary := fmt.Sprintf("Applied to project %s", s.projectID)
+	if len(s.apps) > 0 {
+		summary += fmt.Sprintf(", generated %s code for %s", s.language, strings.Join(s.apps, ", "))
+	}
+	return summary, sources, nil
+}
+
+// generate regenerates the code of the session's apps
+func (s *devSession) generate() error {
+	if len(s.apps) == 0 {
+		return nil
+	}
+
+	apps, err := s.client.ListApps(s.projectID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list apps: %s", err))
+	}
+	appIDs := map[string]string{}
+	for _, app := range apps {
+		appIDs[app.Name] = app.ID
+	}
+
+	for _, name := range s.apps {
+		appID, ok := appIDs[name]
+		if !ok {
+			return errors.New(fmt.Sprintf("app %q not found in project %s", name, s.projectID))
+		}
+		code, err := s.client.GenerateAppCode(appID, s.language)
+		if err != nil {
+			return errors.New(fmt.Sprintf("app %s: %s", name, err))
+		}
+		if _, err := writeGeneratedCode(appID, s.language, code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printDevStatus prints the outcome of a run as a single status line, followed by the
+// problems of an invalid project definition
+func printDevStatus(started time.Time, status string) {
+	line, details, _ := strings.Cut(status, "\n")
+	fmt.Printf("[%s] %s (%s)\n", started.Format("15:04:05"), line, time.Since(started).Round(time.Millisecond))
+	if details != "" {
+		fmt.Println(details)
+	}
+}
+
+// appendMissing appends the files which are not part of files yet
+func appendMissing(files []string, additional []string) []string {
+	for _, file := range additional {
+		found := false
+		for _, existing := range files {
+			if existing == file {
+				found = true
+				break
+			}
+		}
+		if !found {
+			files = append(files, file)
+		}
+	}
+	return files
+}
@@ -0,0 +1,90 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/docs"
+	"github.com/urfave/cli/v3"
+)
+
+func GenerateDocsAction(ctx context.Context, cmd *cli.Command) error {
+	projectID := cmd.String("project-id")
+	filePath := cmd.String("file")
+	outDir := cmd.String("out")
+	format := cmd.String("format")
+	title := cmd.String("title")
+	if outDir == "" {
+		outDir = "site"
+	}
+	if format == "" {
+		format = docs.FormatMarkdown
+	}
+
+	document, err := loadProjectDocument(cmd)
+	if err != nil {
+		return err
+	}
+
+	if title == "" {
+		title = "Event catalog"
+		if filePath != "" {
+			title = fmt.Sprintf("Event catalog of %s", strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath)))
+		}
+	}
+	catalog := docs.NewCatalog(title, document)
+
+	// Version history is only known to the server
+	if projectID != "" {
+		client, err := api.NewFCApiClient()
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+		}
+		if err := addSchemaHistory(client, projectID, catalog); err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to get schema versions: %v", err), 1)
+		}
+	}
+
+	written, err := docs.Generate(catalog, format, outDir)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to generate docs: %v", err), 1)
+	}
+
+	fmt.Printf("Generated %d pages in %s\n", len(written), outDir)
+	return nil
+}
+
+// addSchemaHistory fills in the version history of every schema of the catalog
+func addSchemaHistory(client *api.FCApiClient, projectID string, catalog *docs.Catalog) error {
+	schemaList, err := client.ListSchemas(projectID)
+	if err != nil {
+		return err
+	}
+
+	schemaIDs := make(map[string]string, len(schemaList))
+	for _, schema := range schemaList {
+		schemaIDs[schema.Name] = schema.ID
+	}
+
+	for i := range catalog.Schemas {
+		schemaID, ok := schemaIDs[catalog.Schemas[i].Name]
+		if !ok {
+			continue
+		}
+		versions, err := client.ListSchemaVersions(schemaID)
+		if err != nil {
+			return err
+		}
+		for _, version := range versions {
+			catalog.Schemas[i].History = append(catalog.Schemas[i].History, docs.SchemaVersion{
+				Version:       version.Version,
+				CreatedByName: version.CreatedByName,
+				CreatedAt:     version.CreatedAt,
+			})
+		}
+	}
+	return nil
+}
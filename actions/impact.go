@@ -0,0 +1,212 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/fusioncatalyst/paw/schemas"
+	"github.com/urfave/cli/v3"
+)
+
+// impactReport lists everything affected by a change of a schema or message
+type impactReport struct {
+	Schema   string            `json:"schema"`
+	Messages []impactedMessage `json:"messages"`
+	Apps     []string          `json:"apps"`
+}
+
+type impactedMessage struct {
+	Name          string `json:"name"`
+	SchemaVersion int    `json:"schema_version"`
+	// Compatible is only set when the change was compared with a new schema file
+	Compatible        *bool                     `json:"compatible,omitempty"`
+	Incompatibilities []schemas.Incompatibility `json:"incompatibilities,omitempty"`
+	Apps              []impactedApp             `json:"apps"`
+}
+
+type impactedApp struct {
+	Name      string `json:"name"`
+	Direction string `json:"direction"`
+	Resource  string `json:"resource"`
+	// Artifact is the code generated for the app by `paw codegen app`, when a settings file is present
+	Artifact string `json:"artifact,omitempty"`
+}
+
+func ImpactAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	schemaName := cmd.String("schema")
+	messageName := cmd.String("message")
+	schemaFile := cmd.String("schema-file")
+	breakingOnly := cmd.Bool("breaking-only")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if schemaName == "" && messageName == "" {
+		return cli.Exit("Schema or message name is required. Please provide it using --schema or --message flag", 1)
+	}
+	if schemaName != "" && messageName != "" {
+		return cli.Exit("Only one of --schema and --message flags can be used at a time", 1)
+	}
+	if breakingOnly && schemaFile == "" {
+		return cli.Exit("Schema file is required with --breaking-only. Please provide it using --schema-file flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	// Walk schema -> messages
+	var schema *api.SchemaAPIResponse
+	var messages []api.MessageAPIResponse
+	if messageName != "" {
+		message, err := findMessageByName(client, projectID, messageName)
+		if err != nil {
+			return err
+		}
+		schema, err = findSchemaByID(client, projectID, message.SchemaID)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, *message)
+	} else {
+		schema, err = findSchemaByName(client, projectID, schemaName)
+		if err != nil {
+			return err
+		}
+		allMessages, err := client.ListMessages(projectID)
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to list messages: %s", err))
+		}
+		for _, message := range allMessages {
+			if message.SchemaID == schema.ID {
+				messages = append(messages, message)
+			}
+		}
+	}
+
+	var newContent string
+	if schemaFile != "" {
+		newContent, err = readSchemaFile(contracts.SchemaType(schema.Type), schemaFile)
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to read schema file: %s", err), 1)
+		}
+		if _, err := schemas.Normalize(contracts.SchemaType(schema.Type), newContent); err != nil {
+			return cli.Exit(fmt.Sprintf("Invalid schema file: %s", err), 1)
+		}
+	}
+
+	// Walk messages -> apps -> resources
+	usages, err := listAppMessageUsages(client, projectID)
+	if err != nil {
+		return err
+	}
+	servers, err := provision.ListProjectServers(client, projectID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list resources: %s", err))
+	}
+	resourceURIs := provision.ResourceURIs(servers)
+	language := codegenLanguage()
+
+	report := impactReport{Schema: schema.Name, Messages: []impactedMessage{}, Apps: []string{}}
+	affectedApps := map[string]bool{}
+	for _, message := range messages {
+		impacted := impactedMessage{
+			Name:          message.Name,
+			SchemaVersion: message.SchemaVersion,
+			Apps:          []impactedApp{},
+		}
+
+		// Compare the new schema with the version the message is pinned to
+		if newContent != "" {
+			version, err := client.GetSchemaVersionByNumber(schema.ID, message.SchemaVersion)
+			if err != nil {
+				return errors.New(fmt.Sprintf("failed to get schema version: %s", err))
+			}
+			issues, err := schemas.CheckCompatibility(contracts.SchemaType(schema.Type), version.Schema, newContent)
+			if err != nil {
+				return errors.New(fmt.Sprintf("failed to check compatibility: %s", err))
+			}
+			compatible := len(issues) == 0
+			impacted.Compatible = &compatible
+			impacted.Incompatibilities = issues
+			if compatible && breakingOnly {
+				continue
+			}
+		}
+
+		for _, usage := range usages {
+			if usage.MessageID != message.ID {
+				continue
+			}
+			app := impactedApp{
+				Name:      usage.App.Name,
+				Direction: usage.Direction,
+				Resource:  resourceURIs[usage.ResourceID],
+			}
+			if language != "" {
+				app.Artifact = filepath.Join(codegenOutputDir(), fmt.Sprintf("%s.%s", usage.App.ID, getFileExtension(language)))
+			}
+			impacted.Apps = append(impacted.Apps, app)
+			affectedApps[usage.App.Name] = true
+		}
+
+		report.Messages = append(report.Messages, impacted)
+	}
+
+	for app := range affectedApps {
+		report.Apps = append(report.Apps, app)
+	}
+	sort.Strings(report.Apps)
+
+	// Print formatted JSON
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return errors.New(fmt.Sprintf("failed to encode response: %s", err))
+	}
+
+	return nil
+}
+
+// findSchemaByName looks up a schema in a project by its name
+func findSchemaByName(client *api.FCApiClient, projectID string, name string) (*api.SchemaAPIResponse, error) {
+	schemaList, err := client.ListSchemas(projectID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to list schemas: %s", err))
+	}
+
+	for _, schema := range schemaList {
+		if schema.Name == name {
+			return &schema, nil
+		}
+	}
+
+	return nil, cli.Exit(fmt.Sprintf("Schema %q not found in project %s", name, projectID), 1)
+}
+
+func findSchemaByID(client *api.FCApiClient, projectID string, schemaID string) (*api.SchemaAPIResponse, error) {
+	schemaList, err := client.ListSchemas(projectID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to list schemas: %s", err))
+	}
+
+	for _, schema := range schemaList {
+		if schema.ID == schemaID {
+			return &schema, nil
+		}
+	}
+
+	return nil, cli.Exit(fmt.Sprintf("Schema %s not found in project %s", schemaID, projectID), 1)
+}
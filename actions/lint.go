@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/lint"
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/urfave/cli/v3"
+)
+
+func LintAction(ctx context.Context, cmd *cli.Command) error {
+	format := cmd.String("format")
+	configPath := cmd.String("config")
+	outputPath := cmd.String("out")
+	if format == "" {
+		format = lint.FormatText
+	}
+
+	// Use .pawlint.yaml from the working directory unless another configuration is given
+	config := &lint.Config{}
+	if configPath == "" {
+		if _, err := os.Stat(lint.DefaultConfigFile); err == nil {
+			configPath = lint.DefaultConfigFile
+		}
+	}
+	if configPath != "" {
+		var err error
+		config, err = lint.LoadConfig(configPath)
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to load lint configuration: %v", err), 1)
+		}
+	}
+
+	document, locations, source, err := loadLintSource(cmd)
+	if err != nil {
+		return err
+	}
+
+	findings, err := lint.Run(document, locations, config)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to lint project: %v", err), 1)
+	}
+
+	output, err := lint.Format(findings, format, source)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format lint results: %v", err), 1)
+	}
+
+	if outputPath == "" {
+		fmt.Print(output)
+	} else {
+		if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to write lint results: %v", err), 1)
+		}
+		fmt.Printf("Lint results written to %s\n", outputPath)
+	}
+
+	if errorCount := lint.Count(findings, lint.SeverityError); errorCount > 0 {
+		return cli.Exit(fmt.Sprintf("Lint failed with %d errors", errorCount), 1)
+	}
+	return nil
+}
+
+// loadLintSource loads the project definition to lint and where its entries are defined. Live
+// projects are linted in their exported form, which SARIF logs embed so results can point into it.
+func loadLintSource(cmd *cli.Command) (*contracts.ProvisionYAMLFile, *provision.Locations, lint.Source, error) {
+	filePath := cmd.String("file")
+	projectID := cmd.String("project-id")
+	if filePath != "" && projectID == "" {
+		document, locations, err := loadProvisionFileWithLocations(cmd, filePath)
+		return document, locations, lint.Source{URI: filePath}, err
+	}
+
+	document, err := loadProjectDocument(cmd)
+	if err != nil {
+		return nil, nil, lint.Source{}, err
+	}
+	data, err := provision.Marshal(document)
+	if err != nil {
+		return nil, nil, lint.Source{}, cli.Exit(fmt.Sprintf("Failed to format project definition: %v", err), 1)
+	}
+	source := lint.Source{URI: projectID + ".yaml", Contents: string(data)}
+	locations, err := provision.ParseLocations(data, source.URI)
+	if err != nil {
+		return nil, nil, lint.Source{}, cli.Exit(fmt.Sprintf("Failed to locate project definition entries: %v", err), 1)
+	}
+	return document, locations, source, nil
+}
@@ -1,13 +1,18 @@
 package actions
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/cloudevents"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
 	"github.com/urfave/cli/v3"
 )
 
@@ -67,8 +72,14 @@ func CreateMessageAction(ctx context.Context, cmd *cli.Command) error {
 		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
 	}
 
+	if hasCloudEventFlags(cmd) {
+		if err := checkCloudEventsSchema(client, projectID, schemaID); err != nil {
+			return err
+		}
+	}
+
 	// Create new message
-	message, err := client.CreateMessage(projectID, name, description, schemaID, schemaVersion)
+	message, err := client.CreateMessage(projectID, name, description, schemaID, schemaVersion, cloudEventAttributesFromFlags(cmd, nil, name))
 	if err != nil {
 		return errors.New(fmt.Sprintf("failed to create message: %s", err))
 	}
@@ -82,3 +93,421 @@ func CreateMessageAction(ctx context.Context, cmd *cli.Command) error {
 
 	return nil
 }
+
+func UpdateMessageAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	name := cmd.String("name")
+	description := cmd.String("description")
+	schemaVersion := cmd.Int("schema-version")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if name == "" {
+		return cli.Exit("Message name is required. Please provide it using --name flag", 1)
+	}
+	if description == "" && schemaVersion == 0 && !hasCloudEventFlags(cmd) {
+		return cli.Exit("Nothing to update. Please provide --description, --schema-version or --cloudevents flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	message, err := findMessageByName(client, projectID, name)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the message is not pointed to a version which does not exist
+	if schemaVersion != 0 {
+		if _, err := client.GetSchemaVersionByNumber(message.SchemaID, int(schemaVersion)); err != nil {
+			return cli.Exit(fmt.Sprintf("Schema version %d is not available: %s", schemaVersion, err), 1)
+		}
+	}
+
+	if hasCloudEventFlags(cmd) {
+		if err := checkCloudEventsSchema(client, projectID, message.SchemaID); err != nil {
+			return err
+		}
+	}
+
+	cloudEvents := cloudEventAttributesFromFlags(cmd, message.CloudEvents, message.Name)
+	updatedMessage, err := client.UpdateMessage(message.ID, description, schemaVersion, cloudEvents)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to update message: %s", err))
+	}
+
+	// Print formatted JSON response
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(updatedMessage); err != nil {
+		return errors.New(fmt.Sprintf("failed to encode response: %s", err))
+	}
+
+	return nil
+}
+
+func BumpMessagesAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	name := cmd.String("name")
+	schemaID := cmd.String("schema-id")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if !cmd.Bool("to-latest") {
+		return cli.Exit("Target version is required. Please provide it using --to-latest flag", 1)
+	}
+	if name == "" && schemaID == "" {
+		return cli.Exit("Message name or schema ID is required. Please provide it using --name or --schema-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	// Collect the messages to bump: a single one by name, or every message of a schema
+	var candidates []api.MessageAPIResponse
+	if name != "" {
+		message, err := findMessageByName(client, projectID, name)
+		if err != nil {
+			return err
+		}
+		if schemaID != "" && message.SchemaID != schemaID {
+			return cli.Exit(fmt.Sprintf("Message %q does not use schema %s", name, schemaID), 1)
+		}
+		candidates = append(candidates, *message)
+	} else {
+		messages, err := client.ListMessages(projectID)
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to list messages: %s", err))
+		}
+		for _, message := range messages {
+			if message.SchemaID == schemaID {
+				candidates = append(candidates, message)
+			}
+		}
+	}
+
+	// Resolve the newest version of every schema involved and keep outdated messages only
+	latestVersions := map[string]int{}
+	var outdated []api.MessageAPIResponse
+	for _, message := range candidates {
+		if _, ok := latestVersions[message.SchemaID]; !ok {
+			latest, err := client.GetLatestSchemaVersion(message.SchemaID)
+			if err != nil {
+				return errors.New(fmt.Sprintf("failed to get latest schema version: %s", err))
+			}
+			latestVersions[message.SchemaID] = latest.Version
+		}
+		if message.SchemaVersion < latestVersions[message.SchemaID] {
+			outdated = append(outdated, message)
+		}
+	}
+
+	if len(outdated) == 0 {
+		fmt.Println("All messages already use the latest schema version")
+		return nil
+	}
+
+	affectedApps, err := findAppsUsingMessages(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("The following messages will be bumped:")
+	for _, message := range outdated {
+		fmt.Printf("  %s: schema version %d -> %d\n", message.Name, message.SchemaVersion, latestVersions[message.SchemaID])
+		for _, app := range affectedApps[message.ID] {
+			fmt.Printf("    affects app %s\n", app)
+		}
+	}
+
+	if cmd.Bool("dry-run") {
+		return nil
+	}
+
+	confirmed, err := confirm(cmd, fmt.Sprintf("Bump %d message(s)?", len(outdated)))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cli.Exit("Aborted", 1)
+	}
+
+	for _, message := range outdated {
+		latest := latestVersions[message.SchemaID]
+		if _, err := client.UpdateMessage(message.ID, "", int64(latest), nil); err != nil {
+			return errors.New(fmt.Sprintf("failed to update message %s: %s", message.Name, err))
+		}
+		fmt.Printf("Bumped %s to schema version %d\n", message.Name, latest)
+	}
+
+	return nil
+}
+
+// findAppsUsingMessages maps message IDs to the apps which send or receive them,
+// e.g. "billing (sends)"
+func findAppsUsingMessages(client *api.FCApiClient, projectID string) (map[string][]string, error) {
+	usages, err := listAppMessageUsages(client, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := map[string][]string{}
+	for _, usage := range usages {
+		apps[usage.MessageID] = append(apps[usage.MessageID], fmt.Sprintf("%s (%s)", usage.App.Name, usage.Direction))
+	}
+	return apps, nil
+}
+
+func SampleMessageAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	name := cmd.String("name")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if name == "" {
+		return cli.Exit("Message name is required. Please provide it using --name flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	message, err := findMessageByName(client, projectID, name)
+	if err != nil {
+		return err
+	}
+
+	// Use the exact schema version the message is pinned to
+	schema, err := findSchemaByID(client, projectID, message.SchemaID)
+	if err != nil {
+		return err
+	}
+	version, err := client.GetSchemaVersionByNumber(message.SchemaID, message.SchemaVersion)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to get schema version: %s", err))
+	}
+
+	return printSamples(cmd, contracts.SchemaType(schema.Type), version.Schema, message.CloudEvents)
+}
+
+// hasCloudEventFlags reports whether any of the CloudEvents flags of a message command is set
+func hasCloudEventFlags(cmd *cli.Command) bool {
+	return cmd.Bool("cloudevents") || cmd.String("ce-type") != "" || cmd.String("ce-source") != "" || cmd.String("ce-dataschema") != ""
+}
+
+// cloudEventAttributesFromFlags applies the CloudEvents flags to the current attributes of a
+// message. It returns nil when the flags are not used, the type defaults to the message name.
+func cloudEventAttributesFromFlags(cmd *cli.Command, current *contracts.CloudEventAttributes, messageName string) *contracts.CloudEventAttributes {
+	if !hasCloudEventFlags(cmd) {
+		return nil
+	}
+
+	attributes := contracts.CloudEventAttributes{Type: messageName}
+	if current != nil {
+		attributes = *current
+	}
+	if value := cmd.String("ce-type"); value != "" {
+		attributes.Type = value
+	}
+	if value := cmd.String("ce-source"); value != "" {
+		attributes.Source = value
+	}
+	if value := cmd.String("ce-dataschema"); value != "" {
+		attributes.DataSchema = value
+	}
+	return &attributes
+}
+
+// checkCloudEventsSchema makes sure the schema of a message can be put into a CloudEvents
+// envelope. The envelope is described as a JSON Schema, so its data must be JSON as well.
+func checkCloudEventsSchema(client *api.FCApiClient, projectID string, schemaID string) error {
+	schema, err := findSchemaByID(client, projectID, schemaID)
+	if err != nil {
+		return err
+	}
+	if contracts.SchemaType(schema.Type) != contracts.SchemaTypeJSONSchema {
+		return cli.Exit(fmt.Sprintf("CloudEvents envelopes are only supported for jsonschema schemas, schema %s is %s", schema.Name, schema.Type), 1)
+	}
+	return nil
+}
+
+// findMessageByName looks up a message in a project by its name
+func findMessageByName(client *api.FCApiClient, projectID string, name string) (*api.MessageAPIResponse, error) {
+	messages, err := client.ListMessages(projectID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to list messages: %s", err))
+	}
+
+	for _, message := range messages {
+		if message.Name == name {
+			return &message, nil
+		}
+	}
+
+	return nil, cli.Exit(fmt.Sprintf("Message %q not found in project %s", name, projectID), 1)
+}
+
+// payloadDocument is a single JSON document read from a payload file or stream
+type payloadDocument struct {
+	Source  string
+	Content []byte
+	Err     error
+}
+
+func ValidateMessagePayloadsAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	name := cmd.String("message")
+	files := append(cmd.StringSlice("file"), cmd.Args().Slice()...)
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if name == "" {
+		return cli.Exit("Message name is required. Please provide it using --message flag", 1)
+	}
+
+	// Read documents from the given files, or from stdin when no file is given
+	var documents []payloadDocument
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	for _, file := range files {
+		var data []byte
+		var err error
+		if file == "-" {
+			data, err = io.ReadAll(os.Stdin)
+			file = "stdin"
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to read payload file: %s", err), 1)
+		}
+		documents = append(documents, splitPayloadDocuments(file, data)...)
+	}
+	if len(documents) == 0 {
+		return cli.Exit("No documents to validate", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	message, err := findMessageByName(client, projectID, name)
+	if err != nil {
+		return err
+	}
+
+	// Use the exact schema version the message is pinned to
+	schema, err := findSchemaByID(client, projectID, message.SchemaID)
+	if err != nil {
+		return err
+	}
+	version, err := client.GetSchemaVersionByNumber(message.SchemaID, message.SchemaVersion)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to get schema version: %s", err))
+	}
+
+	dataValidator, err := schemas.NewValidator(contracts.SchemaType(schema.Type), version.Schema, cmd.String("proto-message"))
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to prepare validator: %s", err))
+	}
+
+	// Events of CloudEvents messages are checked as envelopes with the payload in their data
+	var validator interface {
+		Validate(document []byte) []schemas.DocumentError
+	} = dataValidator
+	if message.CloudEvents != nil {
+		validator = cloudevents.NewValidator(*message.CloudEvents, dataValidator)
+	}
+
+	failed := 0
+	for _, document := range documents {
+		var problems []schemas.DocumentError
+		if document.Err != nil {
+			problems = []schemas.DocumentError{{Message: "invalid JSON: " + document.Err.Error()}}
+		} else {
+			problems = validator.Validate(document.Content)
+		}
+
+		if len(problems) == 0 {
+			fmt.Printf("%s: valid\n", document.Source)
+			continue
+		}
+
+		failed++
+		fmt.Printf("%s: invalid\n", document.Source)
+		for _, problem := range problems {
+			pointer := problem.Pointer
+			if pointer == "" {
+				pointer = "/"
+			}
+			fmt.Printf("  %s: %s\n", pointer, problem.Message)
+		}
+	}
+
+	if failed > 0 {
+		return cli.Exit(fmt.Sprintf("%d of %d documents failed validation against %s (schema version %d)", failed, len(documents), message.Name, message.SchemaVersion), 1)
+	}
+
+	fmt.Printf("All %d documents are valid against %s (schema version %d)\n", len(documents), message.Name, message.SchemaVersion)
+	return nil
+}
+
+// splitPayloadDocuments splits the content of a payload file into documents. The content
+// can be a single JSON document, a sequence of documents, or NDJSON with one document per line.
+func splitPayloadDocuments(source string, data []byte) []payloadDocument {
+	var documents []payloadDocument
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			return documents
+		}
+		if err != nil {
+			break
+		}
+		documents = append(documents, payloadDocument{
+			Source:  fmt.Sprintf("%s#%d", source, len(documents)+1),
+			Content: raw,
+		})
+	}
+
+	// Fall back to NDJSON, so a broken line does not hide the documents after it
+	documents = nil
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		document := payloadDocument{
+			Source:  fmt.Sprintf("%s:%d", source, i+1),
+			Content: line,
+		}
+		if !json.Valid(line) {
+			var value interface{}
+			document.Err = json.Unmarshal(line, &value)
+		}
+		documents = append(documents, document)
+	}
+	return documents
+}
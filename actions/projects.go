@@ -5,8 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/graph"
+	"github.com/fusioncatalyst/paw/provision"
 	"github.com/urfave/cli/v3"
 )
 
@@ -105,6 +111,382 @@ func CreateNewProjectAction(_ context.Context, cmd *cli.Command) error {
 	return nil
 }
 
+// projectDetails is a project together with the number of elements it contains
+type projectDetails struct {
+	api.ProjectAPIResponse
+	Counts projectCounts `json:"counts"`
+}
+
+type projectCounts struct {
+	Apps     int `json:"apps"`
+	Schemas  int `json:"schemas"`
+	Messages int `json:"messages"`
+	Servers  int `json:"servers"`
+}
+
+func GetProjectAction(_ context.Context, cmd *cli.Command) error {
+	projectID := cmd.String("project-id")
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to get project: %v", err), 1)
+	}
+	details := projectDetails{ProjectAPIResponse: *project}
+
+	apps, err := client.ListApps(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to list apps: %v", err), 1)
+	}
+	details.Counts.Apps = len(apps)
+
+	schemaList, err := client.ListSchemas(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to list schemas: %v", err), 1)
+	}
+	details.Counts.Schemas = len(schemaList)
+
+	messages, err := client.ListMessages(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to list messages: %v", err), 1)
+	}
+	details.Counts.Messages = len(messages)
+
+	servers, err := client.ListServers(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to list servers: %v", err), 1)
+	}
+	details.Counts.Servers = len(servers.Servers)
+
+	jsonData, err := json.MarshalIndent(details, "", "  ")
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format project data: %v", err), 1)
+	}
+
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+func UpdateProjectAction(_ context.Context, cmd *cli.Command) error {
+	projectID := cmd.String("project-id")
+	visibility := cmd.String("visibility")
+	request := api.UpdateProjectRequest{
+		Name:        cmd.String("name"),
+		Description: cmd.String("description"),
+	}
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	switch visibility {
+	case "":
+	case "private", "public":
+		isPrivate := visibility == "private"
+		request.IsPrivate = &isPrivate
+	default:
+		return cli.Exit("visibility must be either 'private' or 'public'", 1)
+	}
+	if request.Name == "" && request.Description == "" && request.IsPrivate == nil {
+		return cli.Exit("Nothing to update. Please provide --name, --description or --visibility flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	project, err := client.UpdateProject(projectID, request)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to update project: %v", err), 1)
+	}
+
+	jsonData, err := json.MarshalIndent(project, "", "  ")
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format project data: %v", err), 1)
+	}
+
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+func ArchiveProjectAction(_ context.Context, cmd *cli.Command) error {
+	projectID := cmd.String("project-id")
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to get project: %v", err), 1)
+	}
+
+	// Archived projects can no longer be changed, so the name has to be typed like for a deletion
+	confirmed, err := confirmName(cmd, fmt.Sprintf("This archives project %q, it can no longer be changed afterwards. Type the project name to confirm:", project.Name), project.Name)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cli.Exit("Aborted, the project name does not match", 1)
+	}
+
+	if _, err := client.ArchiveProject(projectID); err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to archive project: %v", err), 1)
+	}
+
+	fmt.Printf("Project %s archived\n", project.Name)
+	return nil
+}
+
+func DeleteProjectAction(_ context.Context, cmd *cli.Command) error {
+	projectID := cmd.String("project-id")
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to get project: %v", err), 1)
+	}
+
+	// Deleting a project cannot be undone, so its name has to be typed instead of a yes/no answer
+	confirmed, err := confirmName(cmd, fmt.Sprintf("This deletes project %q with all its servers, schemas, messages and apps. Type the project name to confirm:", project.Name), project.Name)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cli.Exit("Aborted, the project name does not match", 1)
+	}
+
+	if err := client.DeleteProject(projectID); err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to delete project: %v", err), 1)
+	}
+
+	fmt.Printf("Project %s deleted\n", project.Name)
+	return nil
+}
+
+func TransferProjectAction(_ context.Context, cmd *cli.Command) error {
+	projectID := cmd.String("project-id")
+	toWorkspace := cmd.String("to-workspace")
+	toUser := cmd.String("to-user")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if toWorkspace == "" && toUser == "" {
+		return cli.Exit("New owner is required. Please provide it using --to-workspace or --to-user flag", 1)
+	}
+	if toWorkspace != "" && toUser != "" {
+		return cli.Exit("Only one of --to-workspace and --to-user flags can be used at a time", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to get project: %v", err), 1)
+	}
+
+	me, err := client.GetPersonalInfo()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to get personal info: %v", err), 1)
+	}
+
+	workspaces, err := client.ListWorkspaces()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to list workspaces: %v", err), 1)
+	}
+
+	// Only the owner of a personal project, or an owner or admin of its workspace, may give it away
+	switch project.CreatedByType {
+	case "user":
+		if project.CreatedByID != me.ID {
+			return cli.Exit(fmt.Sprintf("Only the owner of project %s can transfer it", project.Name), 1)
+		}
+	case "workspace":
+		if role := workspaceRole(workspaces, project.CreatedByID); !canManageWorkspace(role) {
+			return cli.Exit(fmt.Sprintf("You need to be an owner or admin of %s to transfer its projects", describeProjectOwner(project.CreatedByType, project.CreatedByID, me, workspaces)), 1)
+		}
+	default:
+		return cli.Exit(fmt.Sprintf("Cannot determine the owner of project %s", project.Name), 1)
+	}
+
+	// Resolve the new owner, projects can only be moved into workspaces the caller manages
+	var ownerType, ownerID string
+	if toUser != "" {
+		userID, err := findKnownUser(client, me, workspaces, toUser)
+		if err != nil {
+			return err
+		}
+		ownerType, ownerID = "user", userID
+	}
+	if toWorkspace != "" {
+		workspace, err := findUserWorkspace(workspaces, toWorkspace)
+		if err != nil {
+			return err
+		}
+		if !canManageWorkspace(workspace.Role) {
+			return cli.Exit(fmt.Sprintf("You need to be an owner or admin of workspace %s to transfer projects into it, your role is %s", workspace.Workspace.Name, workspace.Role), 1)
+		}
+		ownerType, ownerID = "workspace", workspace.Workspace.ID
+	}
+
+	from := describeProjectOwner(project.CreatedByType, project.CreatedByID, me, workspaces)
+	to := describeProjectOwner(ownerType, ownerID, me, workspaces)
+	if project.CreatedByType == ownerType && project.CreatedByID == ownerID {
+		return cli.Exit(fmt.Sprintf("Project %s already belongs to %s", project.Name, to), 1)
+	}
+
+	fmt.Printf("Project %s will be transferred:\n", project.Name)
+	fmt.Printf("  owner: %s -> %s\n", from, to)
+
+	if cmd.Bool("dry-run") {
+		return nil
+	}
+
+	confirmed, err := confirm(cmd, "Transfer the project?")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cli.Exit("Aborted", 1)
+	}
+
+	if _, err := client.TransferProject(projectID, ownerType, ownerID); err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to transfer project: %v", err), 1)
+	}
+
+	fmt.Printf("Transferred project %s to %s\n", project.Name, to)
+	return nil
+}
+
+// findProject looks up one of the caller's projects by ID or name
+func findProject(client *api.FCApiClient, idOrName string) (*api.ProjectAPIResponse, error) {
+	projects, err := client.ListProjects()
+	if err != nil {
+		return nil, cli.Exit(fmt.Sprintf("Failed to list projects: %v", err), 1)
+	}
+
+	var matches []api.ProjectAPIResponse
+	for _, project := range projects {
+		if project.ID == idOrName {
+			return &project, nil
+		}
+		if project.Name == idOrName {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, cli.Exit(fmt.Sprintf("Project %s not found", idOrName), 1)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, cli.Exit(fmt.Sprintf("There are %d projects named %s, please use the project ID", len(matches), idOrName), 1)
+	}
+}
+
+// findUserWorkspace looks up one of the caller's workspaces by ID or name
+func findUserWorkspace(workspaces []api.UserWorkspaceAPIResponse, idOrName string) (*api.UserWorkspaceAPIResponse, error) {
+	var matches []api.UserWorkspaceAPIResponse
+	for _, workspace := range workspaces {
+		if workspace.Workspace.ID == idOrName {
+			return &workspace, nil
+		}
+		if workspace.Workspace.Name == idOrName {
+			matches = append(matches, workspace)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, cli.Exit(fmt.Sprintf("Workspace %s not found among your workspaces", idOrName), 1)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, cli.Exit(fmt.Sprintf("There are %d workspaces named %s, please use the workspace ID", len(matches), idOrName), 1)
+	}
+}
+
+// findKnownUser resolves the user a project is transferred to by ID or email. Users can only be
+// looked up through workspaces, so the user has to be the caller or a member of one of the
+// caller's workspaces.
+func findKnownUser(client *api.FCApiClient, me *api.UserInfoAPIResponse, workspaces []api.UserWorkspaceAPIResponse, idOrEmail string) (string, error) {
+	if idOrEmail == "me" || idOrEmail == me.ID {
+		return me.ID, nil
+	}
+
+	for _, workspace := range workspaces {
+		members, err := client.ListWorkspaceMembers(workspace.Workspace.ID)
+		if err != nil {
+			return "", cli.Exit(fmt.Sprintf("Failed to list members of workspace %s: %v", workspace.Workspace.Name, err), 1)
+		}
+		for _, member := range members {
+			if member.UserID == idOrEmail || strings.EqualFold(member.Email, idOrEmail) {
+				return member.UserID, nil
+			}
+		}
+	}
+
+	return "", cli.Exit(fmt.Sprintf("User %s not found, projects can only be transferred to you or to members of your workspaces", idOrEmail), 1)
+}
+
+// workspaceRole returns the caller's role in a workspace, or an empty string if they are not a member
+func workspaceRole(workspaces []api.UserWorkspaceAPIResponse, workspaceID string) string {
+	for _, workspace := range workspaces {
+		if workspace.Workspace.ID == workspaceID {
+			return workspace.Role
+		}
+	}
+	return ""
+}
+
+func canManageWorkspace(role string) bool {
+	return role == "owner" || role == "admin"
+}
+
+// describeProjectOwner renders the owner of a project for the transfer plan
+func describeProjectOwner(ownerType string, ownerID string, me *api.UserInfoAPIResponse, workspaces []api.UserWorkspaceAPIResponse) string {
+	if ownerType == "workspace" {
+		for _, workspace := range workspaces {
+			if workspace.Workspace.ID == ownerID {
+				return fmt.Sprintf("workspace %s (%s)", workspace.Workspace.Name, ownerID)
+			}
+		}
+		return fmt.Sprintf("workspace %s", ownerID)
+	}
+	if ownerID == me.ID {
+		return fmt.Sprintf("user %s (you)", ownerID)
+	}
+	return fmt.Sprintf("user %s", ownerID)
+}
+
 func ImportProjectAction(ctx context.Context, cmd *cli.Command) error {
 	// Get project ID and file path from context
 	projectID := cmd.String("project-id")
@@ -115,6 +497,19 @@ func ImportProjectAction(ctx context.Context, cmd *cli.Command) error {
 		return cli.Exit(fmt.Sprintf("File not found: %s", filePath), 1)
 	}
 
+	// Merge includes and substitute variables, then validate the result locally before uploading it
+	document, err := loadProvisionFile(cmd, filePath)
+	if err != nil {
+		return err
+	}
+	if err := validateProvisionDocument(document); err != nil {
+		return err
+	}
+	content, err := provision.Marshal(document)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format project definition: %v", err), 1)
+	}
+
 	// Initialize API client
 	client, err := api.NewFCApiClient()
 	if err != nil {
@@ -122,7 +517,7 @@ func ImportProjectAction(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	// Import project using API client
-	err = client.ImportProject(projectID, filePath)
+	err = client.ImportProjectDefinition(projectID, content)
 	if err != nil {
 		if apiErr, ok := err.(*api.APIError); ok {
 			return cli.Exit(fmt.Sprintf("Failed to import project: %s", apiErr.Error()), 1)
@@ -133,10 +528,312 @@ func ImportProjectAction(ctx context.Context, cmd *cli.Command) error {
 	return nil
 }
 
+func ValidateProjectFileAction(ctx context.Context, cmd *cli.Command) error {
+	filePath := cmd.String("file")
+
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return cli.Exit(fmt.Sprintf("File not found: %s", filePath), 1)
+	}
+
+	document, err := loadProvisionFile(cmd, filePath)
+	if err != nil {
+		return err
+	}
+	if err := validateProvisionDocument(document); err != nil {
+		return err
+	}
+
+	fmt.Println("Project definition is valid")
+	return nil
+}
+
+func ExportProjectAction(ctx context.Context, cmd *cli.Command) error {
+	projectID := cmd.String("project-id")
+	outputPath := cmd.String("out")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	document, err := provision.Export(client, projectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to export project: %v", err), 1)
+	}
+
+	data, err := provision.Marshal(document)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format project definition: %v", err), 1)
+	}
+
+	if outputPath == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, data, 0644); err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to write project definition: %v", err), 1)
+	}
+
+	fmt.Printf("Project definition exported to %s\n", outputPath)
+	return nil
+}
+
+func PromoteProjectAction(ctx context.Context, cmd *cli.Command) error {
+	from := cmd.String("from")
+	to := cmd.String("to")
+	mappingPath := cmd.String("mapping")
+
+	if from == "" {
+		return cli.Exit("Source project is required. Please provide it using --from flag", 1)
+	}
+	if to == "" {
+		return cli.Exit("Target project is required. Please provide it using --to flag", 1)
+	}
+
+	var mapping *provision.Mapping
+	if mappingPath != "" {
+		var err error
+		mapping, err = provision.LoadMapping(mappingPath)
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to load mapping: %v", err), 1)
+		}
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	fromProject, err := findProject(client, from)
+	if err != nil {
+		return err
+	}
+	toProject, err := findProject(client, to)
+	if err != nil {
+		return err
+	}
+	if fromProject.ID == toProject.ID {
+		return cli.Exit("Source and target project must be different", 1)
+	}
+	fromProjectID, toProjectID := fromProject.ID, toProject.ID
+
+	document, err := provision.Export(client, fromProjectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to export source project: %v", err), 1)
+	}
+	target, err := provision.Export(client, toProjectID)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to export target project: %v", err), 1)
+	}
+
+	if mapping != nil {
+		if err := mapping.Apply(document); err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to apply mapping: %v", err), 1)
+		}
+	}
+
+	var heldBack []provision.HeldBackSchema
+	if cmd.Bool("compatible-only") {
+		heldBack, err = provision.HoldBackIncompatibleSchemas(document, target)
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to check schema compatibility: %v", err), 1)
+		}
+	}
+
+	if err := validateProvisionDocument(document); err != nil {
+		return err
+	}
+
+	changes := provision.Plan(target, document)
+	for _, schema := range heldBack {
+		fmt.Printf("Schema %s is held back, it is not compatible with the target project:\n", schema.Name)
+		for _, issue := range schema.Incompatibilities {
+			fmt.Printf("  %s: %s\n", issue.Path, issue.Message)
+		}
+	}
+	if len(changes) == 0 {
+		fmt.Printf("Project %s is up to date with project %s\n", toProjectID, fromProjectID)
+		return nil
+	}
+
+	fmt.Printf("Promoting project %s to project %s will make the following changes:\n", fromProjectID, toProjectID)
+	for _, change := range changes {
+		fmt.Printf("  %s\n", change)
+	}
+
+	if cmd.Bool("dry-run") {
+		return nil
+	}
+
+	confirmed, err := confirm(cmd, fmt.Sprintf("Apply %d change(s) to project %s?", len(changes), toProjectID))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cli.Exit("Aborted", 1)
+	}
+
+	content, err := provision.Marshal(document)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to format project definition: %v", err), 1)
+	}
+	if err := client.ImportProjectDefinition(toProjectID, content); err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return cli.Exit(fmt.Sprintf("Failed to import project: %s", apiErr), 1)
+		}
+		return cli.Exit(fmt.Sprintf("Failed to import project: %v", err), 1)
+	}
+
+	fmt.Printf("Promoted project %s to project %s\n", fromProjectID, toProjectID)
+	return nil
+}
+
+func ProjectGraphAction(ctx context.Context, cmd *cli.Command) error {
+	format := cmd.String("format")
+	outputPath := cmd.String("out")
+	if format == "" {
+		format = "dot"
+	}
+
+	document, err := loadProjectDocument(cmd)
+	if err != nil {
+		return err
+	}
+
+	topology, err := graph.Build(document, graph.Filter{
+		App:    cmd.String("app"),
+		Server: cmd.String("server"),
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to build project graph: %v", err), 1)
+	}
+
+	output, err := graph.Render(topology, format)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to render project graph: %v", err), 1)
+	}
+
+	if outputPath == "" {
+		fmt.Print(output)
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to write project graph: %v", err), 1)
+	}
+
+	fmt.Printf("Project graph written to %s\n", outputPath)
+	return nil
+}
+
+// loadProjectDocument loads the project definition from a local --file, or exports it
+// from the live project given by --project-id
+func loadProjectDocument(cmd *cli.Command) (*contracts.ProvisionYAMLFile, error) {
+	filePath := cmd.String("file")
+	projectID := cmd.String("project-id")
+
+	if filePath != "" {
+		if projectID != "" {
+			return nil, cli.Exit("Only one of --project-id and --file flags can be used at a time", 1)
+		}
+		return loadProvisionFile(cmd, filePath)
+	}
+
+	if projectID == "" {
+		return nil, cli.Exit("Project ID is required. Please provide it using --project-id or --file flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return nil, cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
+	}
+
+	document, err := provision.Export(client, projectID)
+	if err != nil {
+		return nil, cli.Exit(fmt.Sprintf("Failed to export project: %v", err), 1)
+	}
+	return document, nil
+}
+
+// loadProvisionFile loads a project definition file with its includes, substituting the
+// variables given with --var flags and the environment
+func loadProvisionFile(cmd *cli.Command, filePath string) (*contracts.ProvisionYAMLFile, error) {
+	document, _, err := loadProvisionFileWithLocations(cmd, filePath)
+	return document, err
+}
+
+// loadProvisionFileWithLocations loads a project definition file like loadProvisionFile and
+// also returns where the entries of the document are defined
+func loadProvisionFileWithLocations(cmd *cli.Command, filePath string) (*contracts.ProvisionYAMLFile, *provision.Locations, error) {
+	vars, err := provisionVars(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	document, locations, err := provision.LoadWithLocations(filePath, provision.LoadOptions{Vars: vars})
+	if err != nil {
+		return nil, nil, cli.Exit(fmt.Sprintf("Failed to load project definition: %v", err), 1)
+	}
+	return document, locations, nil
+}
+
+// provisionVars parses the key=value pairs of --var flags
+func provisionVars(cmd *cli.Command) (map[string]string, error) {
+	vars := map[string]string{}
+	for _, assignment := range cmd.StringSlice("var") {
+		key, value, found := strings.Cut(assignment, "=")
+		if !found || key == "" {
+			return nil, cli.Exit(fmt.Sprintf("Invalid variable %q. Please use --var key=value", assignment), 1)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// validateProvisionDocument reports all problems found in a project definition
+func validateProvisionDocument(document *contracts.ProvisionYAMLFile) error {
+	problems := provision.Validate(document)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		messages = append(messages, "  - "+problem.Error())
+	}
+	return cli.Exit(fmt.Sprintf("Project definition is invalid:\n%s", strings.Join(messages, "\n")), 1)
+}
+
+// codeGenerationPollInterval is the time between two status checks of a running code generation
+var codeGenerationPollInterval = 2 * time.Second
+
 func GenerateCodeAction(ctx context.Context, cmd *cli.Command) error {
-	// Get project ID and app ID from context
-	projectID := ctx.Value("project-id").(string)
-	appID := ctx.Value("app-id").(string)
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	appID := cmd.String("app-id")
+	outputDir := cmd.String("out")
+	timeout := cmd.Duration("timeout")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if appID == "" {
+		return cli.Exit("App ID is required. Please provide it using --app-id flag", 1)
+	}
+	if outputDir == "" {
+		outputDir = codegenOutputDir()
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
 
 	// Initialize API client
 	client, err := api.NewFCApiClient()
@@ -144,8 +841,8 @@ func GenerateCodeAction(ctx context.Context, cmd *cli.Command) error {
 		return cli.Exit(fmt.Sprintf("Failed to initialize API client: %v", err), 1)
 	}
 
-	// Generate code using API client
-	project, err := client.GenerateCode(projectID, appID)
+	// Start code generation using API client
+	generation, err := client.GenerateCode(projectID, appID)
 	if err != nil {
 		if apiErr, ok := err.(*api.APIError); ok {
 			return cli.Exit(fmt.Sprintf("Failed to generate code: %s", apiErr), 1)
@@ -153,12 +850,90 @@ func GenerateCodeAction(ctx context.Context, cmd *cli.Command) error {
 		return cli.Exit(fmt.Sprintf("Failed to generate code: %v", err), 1)
 	}
 
-	// Format output as JSON for consistency
-	jsonData, err := json.MarshalIndent(project, "", "  ")
+	if cmd.Bool("no-wait") {
+		// Format output as JSON for consistency
+		jsonData, err := json.MarshalIndent(generation, "", "  ")
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("Failed to format code generation: %v", err), 1)
+		}
+		fmt.Println(string(jsonData))
+		return nil
+	}
+
+	generation, err = waitForCodeGeneration(ctx, client, projectID, appID, generation, timeout)
 	if err != nil {
-		return cli.Exit(fmt.Sprintf("Failed to format project data: %v", err), 1)
+		return err
 	}
 
-	fmt.Println(string(jsonData))
+	paths, err := downloadCodeArtifacts(client, generation, outputDir)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to download generated code: %v", err), 1)
+	}
+
+	fmt.Printf("Downloaded %d files to %s\n", len(paths), outputDir)
+	for _, path := range paths {
+		fmt.Printf("  %s\n", path)
+	}
 	return nil
 }
+
+// waitForCodeGeneration polls a code generation until it completes, fails or the timeout passes
+func waitForCodeGeneration(ctx context.Context, client *api.FCApiClient, projectID string, appID string, generation *api.CodeGenerationAPIResponse, timeout time.Duration) (*api.CodeGenerationAPIResponse, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		switch generation.Status {
+		case api.CodeGenerationCompleted:
+			return generation, nil
+		case api.CodeGenerationFailed:
+			return nil, cli.Exit(fmt.Sprintf("Code generation failed: %s", generation.Error), 1)
+		}
+
+		// Any other status, including a missing or unknown one, is pending until the timeout
+		if generation.ID == "" {
+			return nil, cli.Exit(fmt.Sprintf("Code generation has status %q and no ID to poll it with", generation.Status), 1)
+		}
+		if time.Now().After(deadline) {
+			return nil, cli.Exit(fmt.Sprintf("Timed out after %s waiting for code generation %s, its status is %q", timeout, generation.ID, generation.Status), 1)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(codeGenerationPollInterval):
+		}
+
+		var err error
+		generation, err = client.GetCodeGeneration(projectID, appID, generation.ID)
+		if err != nil {
+			return nil, cli.Exit(fmt.Sprintf("Failed to get code generation status: %v", err), 1)
+		}
+	}
+}
+
+// downloadCodeArtifacts writes the artifacts of a completed code generation into the output
+// directory and returns the paths of the written files
+func downloadCodeArtifacts(client *api.FCApiClient, generation *api.CodeGenerationAPIResponse, outputDir string) ([]string, error) {
+	paths := make([]string, 0, len(generation.Artifacts))
+	for _, artifact := range generation.Artifacts {
+		// Artifact paths come from the server, they must not point outside of the output directory
+		relativePath := filepath.Clean(filepath.FromSlash(artifact.Path))
+		if relativePath == "." || filepath.IsAbs(relativePath) || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("invalid artifact path %q", artifact.Path)
+		}
+		filePath := filepath.Join(outputDir, relativePath)
+
+		content, err := client.DownloadCodeArtifact(artifact.URL)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", artifact.Path, err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create directory for %s: %s", artifact.Path, err)
+		}
+		if err := os.WriteFile(filePath, content, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %s", artifact.Path, err)
+		}
+		paths = append(paths, filePath)
+	}
+	return paths, nil
+}
@@ -0,0 +1,39 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/urfave/cli/v3"
+)
+
+// confirm asks the user to confirm a destructive or bulk operation, unless --yes was given
+func confirm(cmd *cli.Command, message string) (bool, error) {
+	if cmd.Bool("yes") {
+		return true, nil
+	}
+
+	confirmed := false
+	prompt := &survey.Confirm{
+		Message: message,
+	}
+	if err := survey.AskOne(prompt, &confirmed); err != nil {
+		return false, fmt.Errorf("error during survey: %w", err)
+	}
+	return confirmed, nil
+}
+
+// confirmName asks the user to type the name of what is about to be deleted. The name can
+// also be given with --confirm, so scripts can confirm without a prompt.
+func confirmName(cmd *cli.Command, message string, name string) (bool, error) {
+	typed := cmd.String("confirm")
+	if typed == "" {
+		prompt := &survey.Input{
+			Message: message,
+		}
+		if err := survey.AskOne(prompt, &typed); err != nil {
+			return false, fmt.Errorf("error during survey: %w", err)
+		}
+	}
+	return typed == name, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/urfave/cli/v3"
 
@@ -47,6 +48,37 @@ func CreateResourceAction(ctx context.Context, cmd *cli.Command) error {
 	description := cmd.String("description")
 	resourceType := cmd.String("type")
 	mode := cmd.String("mode")
+	var server *contracts.Server
+
+	if err := checkResourceURIFlags(cmd); err != nil {
+		return err
+	}
+
+	// A resource URI replaces the server, name, type and mode flags
+	if rawURI := cmd.String("resource"); rawURI != "" {
+		if serverID != "" || name != "" || resourceType != "" || mode != "" {
+			return errors.New("--resource cannot be combined with --server-id, --name, --type or --mode")
+		}
+
+		client, err := api.NewFCApiClient()
+		if err != nil {
+			return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+		}
+
+		resolved, err := resolveResourceURI(client, cmd.String("project-id"), rawURI)
+		if err != nil {
+			return err
+		}
+		if resolved.Resource != nil {
+			return errors.New(fmt.Sprintf("Resource %s already exists", resolved.URI))
+		}
+
+		server = &resolved.Server
+		serverID = resolved.Server.ID
+		name = resolved.URI.Name
+		resourceType = string(resolved.URI.Type)
+		mode = string(resolved.URI.Mode)
+	}
 
 	if serverID == "" {
 		return errors.New("Server ID is required")
@@ -90,6 +122,17 @@ func CreateResourceAction(ctx context.Context, cmd *cli.Command) error {
 		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
 	}
 
+	// Check the combination of type and mode against what the server protocol supports
+	if server == nil {
+		server, err = client.GetServer(serverID)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Failed to get server: %v", err))
+		}
+	}
+	if err := contracts.ValidateResourceForProtocol(server.Protocol, resourceTypeEnum, resourceModeEnum); err != nil {
+		return errors.New(fmt.Sprintf("Invalid resource: %v", err))
+	}
+
 	resource := contracts.CreateResourceRequest{
 		Name:         name,
 		Description:  description,
@@ -112,4 +155,237 @@ func CreateResourceAction(ctx context.Context, cmd *cli.Command) error {
 
 	fmt.Println(string(jsonData))
 	return nil
-}
\ No newline at end of file
+}
+
+// resolvedResource is a resource URI resolved against the servers of a project
+type resolvedResource struct {
+	URI    *contracts.ResourceURI
+	Server contracts.Server
+	// Resource is nil when the server has no resource matching the URI yet
+	Resource *contracts.ResourceResponse
+}
+
+// resolveResourceURI parses a resource URI and looks up the server and resource it points to
+func resolveResourceURI(client *api.FCApiClient, projectID string, rawURI string) (*resolvedResource, error) {
+	if projectID == "" {
+		return nil, errors.New("Project ID is required to resolve a resource URI")
+	}
+
+	uri, err := contracts.ParseResourceURI(rawURI)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := client.ListServers(projectID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to list servers: %v", err))
+	}
+
+	for _, server := range servers.Servers {
+		if server.Name != uri.Server {
+			continue
+		}
+		if err := uri.ValidateForServer(server.Name, server.Protocol); err != nil {
+			return nil, err
+		}
+
+		resolved := &resolvedResource{URI: uri, Server: server}
+
+		resources, err := client.ListServerResources(server.ID)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("Failed to list resources: %v", err))
+		}
+		for _, resource := range resources {
+			if resource.Name != uri.Name {
+				continue
+			}
+			if resource.ResourceType != string(uri.Type) || resource.Mode != string(uri.Mode) {
+				return nil, errors.New(fmt.Sprintf("Resource %q of server %q is a %s in %s mode, not as referenced by %s", resource.Name, server.Name, resource.ResourceType, resource.Mode, uri))
+			}
+			resolved.Resource = &resource
+			break
+		}
+
+		return resolved, nil
+	}
+
+	return nil, errors.New(fmt.Sprintf("Server %q not found in project %s", uri.Server, projectID))
+}
+
+func GetResourceAction(ctx context.Context, cmd *cli.Command) error {
+	if err := checkResourceURIFlags(cmd); err != nil {
+		return err
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	resource, err := lookupResource(client, cmd)
+	if err != nil {
+		return err
+	}
+
+	jsonData, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to format resource: %v", err))
+	}
+
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+func UpdateResourceAction(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.String("name")
+	description := cmd.String("description")
+	mode := cmd.String("mode")
+
+	if name == "" && description == "" && mode == "" {
+		return errors.New("Nothing to update. Provide --name, --description or --mode")
+	}
+	if err := checkResourceURIFlags(cmd); err != nil {
+		return err
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	resource, err := lookupResource(client, cmd)
+	if err != nil {
+		return err
+	}
+
+	// A new mode has to be supported by the server protocol
+	if mode != "" {
+		server, err := client.GetServer(resource.ServerID)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Failed to get server: %v", err))
+		}
+		if err := contracts.ValidateResourceForProtocol(server.Protocol, contracts.ResourceType(resource.ResourceType), contracts.ResourceMode(mode)); err != nil {
+			return errors.New(fmt.Sprintf("Invalid resource: %v", err))
+		}
+	}
+
+	confirmed, err := confirm(cmd, fmt.Sprintf("Update resource %q?", resource.Name))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.New("Aborted")
+	}
+
+	updatedResource, err := client.UpdateResource(resource.ID, contracts.UpdateResourceRequest{
+		Name:        name,
+		Description: description,
+		Mode:        contracts.ResourceMode(mode),
+	})
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to update resource: %v", err))
+	}
+
+	jsonData, err := json.MarshalIndent(updatedResource, "", "  ")
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to format resource: %v", err))
+	}
+
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+func DeleteResourceAction(ctx context.Context, cmd *cli.Command) error {
+	if err := checkResourceURIFlags(cmd); err != nil {
+		return err
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	resource, err := lookupResource(client, cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := checkResourceDependents(client, resource.ProjectID, map[string]bool{resource.ID: true}, cmd.Bool("force")); err != nil {
+		return err
+	}
+
+	confirmed, err := confirm(cmd, fmt.Sprintf("Delete resource %q?", resource.Name))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.New("Aborted")
+	}
+
+	if err := client.DeleteResource(resource.ID); err != nil {
+		return errors.New(fmt.Sprintf("Failed to delete resource: %v", err))
+	}
+
+	fmt.Printf("Resource %s deleted\n", resource.Name)
+	return nil
+}
+
+// checkResourceURIFlags verifies before any request is made that a --resource URI comes with
+// the project its server is looked up in
+func checkResourceURIFlags(cmd *cli.Command) error {
+	if cmd.String("resource") != "" && cmd.String("project-id") == "" {
+		return errors.New("Project ID is required with --resource. Please provide it using --project-id flag")
+	}
+	return nil
+}
+
+// lookupResource finds the resource given by --resource-id or by a --resource URI
+func lookupResource(client *api.FCApiClient, cmd *cli.Command) (*contracts.ResourceResponse, error) {
+	if rawURI := cmd.String("resource"); rawURI != "" {
+		resolved, err := resolveResourceURI(client, cmd.String("project-id"), rawURI)
+		if err != nil {
+			return nil, err
+		}
+		if resolved.Resource == nil {
+			return nil, errors.New(fmt.Sprintf("Resource %s not found", resolved.URI))
+		}
+		return resolved.Resource, nil
+	}
+
+	resourceID := cmd.String("resource-id")
+	if resourceID == "" {
+		return nil, errors.New("Resource ID is required (use --resource-id or --resource)")
+	}
+
+	resource, err := client.GetResource(resourceID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to get resource: %v", err))
+	}
+	return resource, nil
+}
+
+// checkResourceDependents refuses to continue while apps send or receive messages through
+// one of the resources, unless force is set. The dependents are listed in the error.
+func checkResourceDependents(client *api.FCApiClient, projectID string, resourceIDs map[string]bool, force bool) error {
+	usages, err := listAppMessageUsages(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	var dependents []string
+	for _, usage := range usages {
+		if resourceIDs[usage.ResourceID] {
+			dependents = append(dependents, fmt.Sprintf("app %s %s %s via %s", usage.App.Name, usage.Direction, usage.MessageName, usage.ResourceName))
+		}
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	if force {
+		fmt.Printf("Warning: %d dependent(s) will be left without a resource:\n  %s\n", len(dependents), strings.Join(dependents, "\n  "))
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("Refusing to delete, still in use by (use --force to delete anyway):\n  %s", strings.Join(dependents, "\n  ")))
+}
@@ -1,13 +1,20 @@
 package actions
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"os"
+	"time"
 
 	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/cloudevents"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
+	"github.com/google/uuid"
 	"github.com/urfave/cli/v3"
 )
 
@@ -60,13 +67,16 @@ func CreateSchemaAction(ctx context.Context, cmd *cli.Command) error {
 	if schemaFile == "" {
 		return cli.Exit("Schema file is required. Please provide it using --schema-file flag", 1)
 	}
+	parsedSchemaType, err := schemas.ParseType(schemaType)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Invalid schema type: %s", err), 1)
+	}
 
 	// Read schema content from file
-	content, err := os.ReadFile(schemaFile)
+	finalSchemaContent, err := readSchemaFile(parsedSchemaType, schemaFile)
 	if err != nil {
 		return cli.Exit(fmt.Sprintf("Failed to read schema file: %s", err), 1)
 	}
-	finalSchemaContent := string(content)
 
 	// Initialize API client
 	client, err := api.NewFCApiClient()
@@ -94,7 +104,11 @@ func UpdateSchemaAction(ctx context.Context, cmd *cli.Command) error {
 	// Get required parameters from command flags
 	schemaID := cmd.String("schema-id")
 	schemaFile := cmd.String("schema-file")
+	projectID := cmd.String("project-id")
 
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
 	if schemaID == "" {
 		return cli.Exit("Schema ID is required. Please provide it using --schema-id flag", 1)
 	}
@@ -102,21 +116,27 @@ func UpdateSchemaAction(ctx context.Context, cmd *cli.Command) error {
 		return cli.Exit("Schema file is required. Please provide it using --schema-file flag", 1)
 	}
 
-	// Read schema content from file
-	content, err := os.ReadFile(schemaFile)
-	if err != nil {
-		return cli.Exit(fmt.Sprintf("Failed to read schema file: %s", err), 1)
-	}
-	finalSchemaContent := string(content)
-
 	// Initialize API client
 	client, err := api.NewFCApiClient()
 	if err != nil {
 		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
 	}
 
+	// Validate the new content according to the type of the existing schema
+	existingSchema, err := findSchemaByID(client, projectID, schemaID)
+	if err != nil {
+		return err
+	}
+	schemaType := contracts.SchemaType(existingSchema.Type)
+
+	// Read schema content from file
+	finalSchemaContent, err := readSchemaFile(schemaType, schemaFile)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to read schema file: %s", err), 1)
+	}
+
 	// Update schema
-	schema, err := client.UpdateSchema(schemaID, finalSchemaContent)
+	schema, err := client.UpdateSchema(schemaID, string(schemaType), finalSchemaContent)
 	if err != nil {
 		return errors.New(fmt.Sprintf("failed to update schema: %s", err))
 	}
@@ -165,12 +185,28 @@ func GetSchemaVersionAction(ctx context.Context, cmd *cli.Command) error {
 	// Get required parameters from command flags
 	schemaID := cmd.String("schema-id")
 	versionID := cmd.String("version-id")
+	versionNumber := cmd.Int("version")
+	latest := cmd.Bool("latest")
+	schemaOnly := cmd.Bool("schema-only")
 
 	if schemaID == "" {
 		return cli.Exit("Schema ID is required. Please provide it using --schema-id flag", 1)
 	}
-	if versionID == "" {
-		return cli.Exit("Version ID is required. Please provide it using --version-id flag", 1)
+
+	selectors := 0
+	for _, isSet := range []bool{versionID != "", versionFlagSet(cmd), latest} {
+		if isSet {
+			selectors++
+		}
+	}
+	if selectors == 0 {
+		return cli.Exit("Version ID is required. Please provide it using --version-id, --version or --latest flag", 1)
+	}
+	if selectors > 1 {
+		return cli.Exit("Only one of --version-id, --version and --latest flags can be used at a time", 1)
+	}
+	if versionFlagSet(cmd) && versionNumber < 1 {
+		return cli.Exit("Version number must be a positive integer", 1)
 	}
 
 	// Initialize API client
@@ -180,11 +216,24 @@ func GetSchemaVersionAction(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	// Get specific schema version
-	version, err := client.GetSchemaVersion(schemaID, versionID)
+	var version *api.SchemaVersionAPIResponse
+	switch {
+	case latest:
+		version, err = client.GetLatestSchemaVersion(schemaID)
+	case versionFlagSet(cmd):
+		version, err = client.GetSchemaVersionByNumber(schemaID, int(versionNumber))
+	default:
+		version, err = client.GetSchemaVersion(schemaID, versionID)
+	}
 	if err != nil {
 		return errors.New(fmt.Sprintf("failed to get schema version: %s", err))
 	}
 
+	// Print only the decoded schema body if requested
+	if schemaOnly {
+		return printSchemaBody(version.Schema)
+	}
+
 	// Print formatted JSON response
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
@@ -194,3 +243,267 @@ func GetSchemaVersionAction(ctx context.Context, cmd *cli.Command) error {
 
 	return nil
 }
+
+// versionFlagSet reports whether a schema version number was given. --version 0 counts as
+// given, so that it is rejected instead of being taken for the latest version.
+func versionFlagSet(cmd *cli.Command) bool {
+	return cmd.IsSet("version") || cmd.Int("version") != 0
+}
+
+// printSchemaBody prints the schema content stored in a schema version. JSON based schemas
+// are printed as formatted JSON, other schemas (e.g. Protobuf) are printed as they are.
+func printSchemaBody(schema string) error {
+	if !json.Valid([]byte(schema)) {
+		fmt.Println(schema)
+		return nil
+	}
+
+	var formatted bytes.Buffer
+	if err := json.Indent(&formatted, []byte(schema), "", "  "); err != nil {
+		return errors.New(fmt.Sprintf("failed to decode schema content: %s", err))
+	}
+
+	fmt.Println(formatted.String())
+	return nil
+}
+
+func CheckSchemaCompatibilityAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	schemaID := cmd.String("schema-id")
+	schemaFile := cmd.String("schema-file")
+	versionNumber := cmd.Int("version")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if schemaID == "" {
+		return cli.Exit("Schema ID is required. Please provide it using --schema-id flag", 1)
+	}
+	if schemaFile == "" {
+		return cli.Exit("Schema file is required. Please provide it using --schema-file flag", 1)
+	}
+	if versionFlagSet(cmd) && versionNumber < 1 {
+		return cli.Exit("Version number must be a positive integer", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	schema, err := findSchemaByID(client, projectID, schemaID)
+	if err != nil {
+		return err
+	}
+
+	// Read schema content from file
+	content, err := readSchemaFile(contracts.SchemaType(schema.Type), schemaFile)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to read schema file: %s", err), 1)
+	}
+
+	// Compare with the requested version, or the latest one by default
+	var version *api.SchemaVersionAPIResponse
+	if versionFlagSet(cmd) {
+		version, err = client.GetSchemaVersionByNumber(schemaID, int(versionNumber))
+	} else {
+		version, err = client.GetLatestSchemaVersion(schemaID)
+	}
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to get schema version: %s", err))
+	}
+
+	if _, err := schemas.Normalize(contracts.SchemaType(schema.Type), content); err != nil {
+		return cli.Exit(fmt.Sprintf("Invalid schema file: %s", err), 1)
+	}
+
+	issues, err := schemas.CheckCompatibility(contracts.SchemaType(schema.Type), version.Schema, content)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to check compatibility: %s", err))
+	}
+
+	result := struct {
+		SchemaID          string                    `json:"schema_id"`
+		Type              string                    `json:"type"`
+		ComparedVersion   int                       `json:"compared_version"`
+		Compatible        bool                      `json:"compatible"`
+		Incompatibilities []schemas.Incompatibility `json:"incompatibilities"`
+	}{
+		SchemaID:          schemaID,
+		Type:              schema.Type,
+		ComparedVersion:   version.Version,
+		Compatible:        len(issues) == 0,
+		Incompatibilities: issues,
+	}
+	if result.Incompatibilities == nil {
+		result.Incompatibilities = []schemas.Incompatibility{}
+	}
+
+	// Print formatted JSON response
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		return errors.New(fmt.Sprintf("failed to encode response: %s", err))
+	}
+
+	if len(issues) > 0 {
+		return cli.Exit(fmt.Sprintf("Schema is not compatible with version %d", version.Version), 1)
+	}
+
+	return nil
+}
+
+func BundleSchemaAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	schemaFile := cmd.String("schema-file")
+	outputPath := cmd.String("out")
+
+	if schemaFile == "" {
+		return cli.Exit("Schema file is required. Please provide it using --schema-file flag", 1)
+	}
+
+	bundledSchema, err := schemas.BundleFile(schemaFile)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to bundle schema: %s", err), 1)
+	}
+
+	if _, err := schemas.Normalize(contracts.SchemaTypeJSONSchema, bundledSchema); err != nil {
+		return cli.Exit(fmt.Sprintf("Bundled schema is invalid: %s", err), 1)
+	}
+
+	if outputPath == "" {
+		fmt.Println(bundledSchema)
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, []byte(bundledSchema+"\n"), 0644); err != nil {
+		return cli.Exit(fmt.Sprintf("Failed to write bundled schema: %s", err), 1)
+	}
+
+	fmt.Printf("Bundled schema saved to %s\n", outputPath)
+	return nil
+}
+
+// readSchemaFile reads the content of a schema file. JSON Schemas referencing other
+// local files are bundled into a single document.
+func readSchemaFile(schemaType contracts.SchemaType, schemaFile string) (string, error) {
+	if schemaType == contracts.SchemaTypeJSONSchema {
+		return schemas.BundleFile(schemaFile)
+	}
+
+	content, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func SampleSchemaAction(ctx context.Context, cmd *cli.Command) error {
+	// Get required parameters from command flags
+	projectID := cmd.String("project-id")
+	schemaID := cmd.String("schema-id")
+	versionNumber := cmd.Int("version")
+
+	if projectID == "" {
+		return cli.Exit("Project ID is required. Please provide it using --project-id flag", 1)
+	}
+	if schemaID == "" {
+		return cli.Exit("Schema ID is required. Please provide it using --schema-id flag", 1)
+	}
+	if versionFlagSet(cmd) && versionNumber < 1 {
+		return cli.Exit("Version number must be a positive integer", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	schema, err := findSchemaByID(client, projectID, schemaID)
+	if err != nil {
+		return err
+	}
+
+	// Use the requested version, or the latest one by default
+	var version *api.SchemaVersionAPIResponse
+	if versionFlagSet(cmd) {
+		version, err = client.GetSchemaVersionByNumber(schemaID, int(versionNumber))
+	} else {
+		version, err = client.GetLatestSchemaVersion(schemaID)
+	}
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to get schema version: %s", err))
+	}
+
+	return printSamples(cmd, contracts.SchemaType(schema.Type), version.Schema, nil)
+}
+
+// printSamples generates sample documents for a schema according to the
+// --count, --seed and --ndjson flags and prints them. Samples of CloudEvents
+// messages are wrapped into envelopes.
+func printSamples(cmd *cli.Command, schemaType contracts.SchemaType, schemaContent string, cloudEvents *contracts.CloudEventAttributes) error {
+	count := cmd.Int("count")
+	seed := cmd.Int("seed")
+	ndjson := cmd.Bool("ndjson")
+
+	if count == 0 {
+		count = 1
+	}
+	if count < 0 {
+		return cli.Exit("Count must be a positive integer", 1)
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	if schemaType != contracts.SchemaTypeJSONSchema {
+		return cli.Exit(fmt.Sprintf("Samples can only be generated for jsonschema schemas, got %s", schemaType), 1)
+	}
+
+	sampler, err := schemas.NewSampler(schemaContent, seed)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to prepare sample generator: %s", err))
+	}
+
+	// Event IDs come from the seed as well, so envelopes are reproducible
+	ids := rand.New(rand.NewSource(seed))
+	samples := make([]interface{}, 0, count)
+	for i := int64(0); i < count; i++ {
+		sample, err := sampler.Generate()
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to generate sample: %s", err))
+		}
+		if cloudEvents != nil {
+			id, err := uuid.NewRandomFromReader(ids)
+			if err != nil {
+				return errors.New(fmt.Sprintf("failed to generate event ID: %s", err))
+			}
+			sample = cloudevents.Wrap(*cloudEvents, id.String(), sample)
+		}
+		samples = append(samples, sample)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	if ndjson {
+		for _, sample := range samples {
+			if err := encoder.Encode(sample); err != nil {
+				return errors.New(fmt.Sprintf("failed to encode sample: %s", err))
+			}
+		}
+		return nil
+	}
+
+	// Print formatted JSON, a single sample is printed on its own
+	encoder.SetIndent("", "  ")
+	var output interface{} = samples
+	if count == 1 {
+		output = samples[0]
+	}
+	if err := encoder.Encode(output); err != nil {
+		return errors.New(fmt.Sprintf("failed to encode sample: %s", err))
+	}
+
+	return nil
+}
@@ -28,6 +28,9 @@ func CreateServer(ctx context.Context, cmd *cli.Command) error {
 	if projectID == "" {
 		return errors.New("Project ID is required")
 	}
+	if err := contracts.ValidateProtocol(serverType); err != nil {
+		return errors.New(fmt.Sprintf("Invalid server: %v", err))
+	}
 
 	client, err := api.NewFCApiClient()
 	if err != nil {
@@ -89,3 +92,122 @@ func ListServers(ctx context.Context, cmd *cli.Command) error {
 	return nil
 }
 
+
+func GetServer(ctx context.Context, cmd *cli.Command) error {
+	serverID := cmd.String("server-id")
+
+	if serverID == "" {
+		return errors.New("Server ID is required")
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	server, err := client.GetServer(serverID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to get server: %v", err))
+	}
+
+	jsonData, err := json.MarshalIndent(server, "", "  ")
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to format response: %v", err))
+	}
+	fmt.Println(string(jsonData))
+
+	return nil
+}
+
+func UpdateServer(ctx context.Context, cmd *cli.Command) error {
+	serverID := cmd.String("server-id")
+	name := cmd.String("name")
+	description := cmd.String("description")
+
+	if serverID == "" {
+		return errors.New("Server ID is required")
+	}
+	if name == "" && description == "" {
+		return errors.New("Nothing to update. Provide --name or --description")
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	server, err := client.GetServer(serverID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to get server: %v", err))
+	}
+
+	confirmed, err := confirm(cmd, fmt.Sprintf("Update server %q?", server.Name))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.New("Aborted")
+	}
+
+	result, err := client.UpdateServer(serverID, &contracts.UpdateServerRequest{
+		Name:        name,
+		Description: description,
+	})
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to update server: %v", err))
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to format response: %v", err))
+	}
+	fmt.Println(string(jsonData))
+
+	return nil
+}
+
+func DeleteServer(ctx context.Context, cmd *cli.Command) error {
+	serverID := cmd.String("server-id")
+
+	if serverID == "" {
+		return errors.New("Server ID is required")
+	}
+
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to initialize API client: %v", err))
+	}
+
+	server, err := client.GetServer(serverID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to get server: %v", err))
+	}
+
+	// Deleting a server deletes its resources, so their dependents are checked as well
+	resources, err := client.ListServerResources(serverID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to list resources: %v", err))
+	}
+	resourceIDs := map[string]bool{}
+	for _, resource := range resources {
+		resourceIDs[resource.ID] = true
+	}
+	if err := checkResourceDependents(client, server.ProjectID, resourceIDs, cmd.Bool("force")); err != nil {
+		return err
+	}
+
+	confirmed, err := confirm(cmd, fmt.Sprintf("Delete server %q and its %d resource(s)?", server.Name, len(resources)))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.New("Aborted")
+	}
+
+	if err := client.DeleteServer(serverID); err != nil {
+		return errors.New(fmt.Sprintf("Failed to delete server: %v", err))
+	}
+
+	fmt.Printf("Server %s deleted\n", server.Name)
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/fusioncatalyst/paw/api"
 	"github.com/urfave/cli/v3"
@@ -64,3 +65,301 @@ func CreateWorkspaceAction(ctx context.Context, cmd *cli.Command) error {
 
 	return nil
 }
+
+func GetWorkspaceAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	workspace, err := client.GetWorkspace(workspaceID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to get workspace: %s", err))
+	}
+
+	return printJSON(workspace)
+}
+
+func UpdateWorkspaceAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	name := cmd.String("name")
+	description := cmd.String("description")
+
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+	if name == "" && description == "" {
+		return cli.Exit("Nothing to update. Please provide --name or --description flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	workspace, err := client.UpdateWorkspace(workspaceID, name, description)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to update workspace: %s", err))
+	}
+
+	return printJSON(workspace)
+}
+
+func DeleteWorkspaceAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	workspace, err := client.GetWorkspace(workspaceID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to get workspace: %s", err))
+	}
+
+	confirmed, err := confirmName(cmd, fmt.Sprintf("This deletes workspace %q and removes its %d member(s). Type the workspace name to confirm:", workspace.Name, workspace.Users), workspace.Name)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cli.Exit("Aborted, the workspace name does not match", 1)
+	}
+
+	if err := client.DeleteWorkspace(workspaceID); err != nil {
+		return errors.New(fmt.Sprintf("failed to delete workspace: %s", err))
+	}
+
+	fmt.Printf("Workspace %s deleted\n", workspace.Name)
+	return nil
+}
+
+func ListWorkspaceProjectsAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	projects, err := client.ListWorkspaceProjects(workspaceID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list workspace projects: %s", err))
+	}
+	if projects == nil {
+		projects = []api.ProjectAPIResponse{}
+	}
+
+	return printJSON(projects)
+}
+
+func ListWorkspaceMembersAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	members, err := client.ListWorkspaceMembers(workspaceID)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to list workspace members: %s", err))
+	}
+
+	return printJSON(members)
+}
+
+func AddWorkspaceMemberAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	email := cmd.String("email")
+	role := cmd.String("role")
+	if role == "" {
+		role = "member"
+	}
+
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+	if email == "" {
+		return cli.Exit("Email is required. Please provide it using --email flag", 1)
+	}
+	if err := validateWorkspaceRole(role); err != nil {
+		return err
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	member, err := client.AddWorkspaceMember(workspaceID, email, role)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to add workspace member: %s", err))
+	}
+
+	return printJSON(member)
+}
+
+func SetWorkspaceMemberRoleAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	role := cmd.String("role")
+
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+	if role == "" {
+		return cli.Exit("Role is required. Please provide it using --role flag", 1)
+	}
+	if err := validateWorkspaceRole(role); err != nil {
+		return err
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	member, err := findWorkspaceMember(client, workspaceID, cmd.String("user-id"), cmd.String("email"))
+	if err != nil {
+		return err
+	}
+
+	updated, err := client.SetWorkspaceMemberRole(workspaceID, member.UserID, role)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to set role of workspace member: %s", err))
+	}
+
+	return printJSON(updated)
+}
+
+func RemoveWorkspaceMemberAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	member, err := findWorkspaceMember(client, workspaceID, cmd.String("user-id"), cmd.String("email"))
+	if err != nil {
+		return err
+	}
+
+	confirmed, err := confirm(cmd, fmt.Sprintf("Remove %s from the workspace?", member.Email))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cli.Exit("Aborted", 1)
+	}
+
+	if err := client.RemoveWorkspaceMember(workspaceID, member.UserID); err != nil {
+		return errors.New(fmt.Sprintf("failed to remove workspace member: %s", err))
+	}
+
+	fmt.Printf("Removed %s from the workspace\n", member.Email)
+	return nil
+}
+
+func InviteToWorkspaceAction(ctx context.Context, cmd *cli.Command) error {
+	workspaceID := cmd.String("workspace-id")
+	email := cmd.String("email")
+	role := cmd.String("role")
+	if role == "" {
+		role = "member"
+	}
+
+	if workspaceID == "" {
+		return cli.Exit("Workspace ID is required. Please provide it using --workspace-id flag", 1)
+	}
+	if email == "" {
+		return cli.Exit("Email is required. Please provide it using --email flag", 1)
+	}
+	if err := validateWorkspaceRole(role); err != nil {
+		return err
+	}
+
+	// Initialize API client
+	client, err := api.NewFCApiClient()
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to initialize API client: %s", err))
+	}
+
+	invitation, err := client.InviteToWorkspace(workspaceID, email, role)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to invite to workspace: %s", err))
+	}
+
+	return printJSON(invitation)
+}
+
+// findWorkspaceMember looks up a member of a workspace by user ID or email
+func findWorkspaceMember(client *api.FCApiClient, workspaceID string, userID string, email string) (*api.WorkspaceMemberAPIResponse, error) {
+	if userID == "" && email == "" {
+		return nil, cli.Exit("Member is required. Please provide it using --user-id or --email flag", 1)
+	}
+	if userID != "" && email != "" {
+		return nil, cli.Exit("Only one of --user-id and --email flags can be used at a time", 1)
+	}
+
+	members, err := client.ListWorkspaceMembers(workspaceID)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to list workspace members: %s", err))
+	}
+
+	for _, member := range members {
+		if (userID != "" && member.UserID == userID) || (email != "" && strings.EqualFold(member.Email, email)) {
+			return &member, nil
+		}
+	}
+
+	if userID != "" {
+		return nil, cli.Exit(fmt.Sprintf("User %s is not a member of workspace %s", userID, workspaceID), 1)
+	}
+	return nil, cli.Exit(fmt.Sprintf("%s is not a member of workspace %s", email, workspaceID), 1)
+}
+
+// validateWorkspaceRole checks that a role is one of the workspace roles
+func validateWorkspaceRole(role string) error {
+	for _, known := range api.WorkspaceRoles {
+		if role == known {
+			return nil
+		}
+	}
+	return cli.Exit(fmt.Sprintf("Invalid role: %s. Must be one of: %s", role, strings.Join(api.WorkspaceRoles, ", ")), 1)
+}
+
+// printJSON prints an API response as formatted JSON
+func printJSON(value interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return errors.New(fmt.Sprintf("failed to encode response: %s", err))
+	}
+	return nil
+}
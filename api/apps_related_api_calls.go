@@ -1 +1,161 @@
 package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// AppMessageAPIResponse represents a message an app sends or receives through a resource
+type AppMessageAPIResponse struct {
+	ID           string `json:"id"`
+	AppID        string `json:"app_id"`
+	MessageID    string `json:"message_id"`
+	MessageName  string `json:"message_name"`
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+}
+
+// ListAppSends retrieves the messages an app sends
+func (c *FCApiClient) ListAppSends(appID string) ([]AppMessageAPIResponse, error) {
+	return c.listAppMessages(appID, "sends")
+}
+
+// ListAppReceives retrieves the messages an app receives
+func (c *FCApiClient) ListAppReceives(appID string) ([]AppMessageAPIResponse, error) {
+	return c.listAppMessages(appID, "receives")
+}
+
+func (c *FCApiClient) listAppMessages(appID string, direction string) ([]AppMessageAPIResponse, error) {
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/apps/%s/%s", c.host, appID, direction)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var messages []AppMessageAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&messages); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return messages, nil
+}
+
+// AddAppSend registers that an app sends a message through a resource
+func (c *FCApiClient) AddAppSend(appID string, messageID string, resourceID string) (*AppMessageAPIResponse, error) {
+	return c.addAppMessage(appID, "sends", messageID, resourceID)
+}
+
+// AddAppReceive registers that an app receives a message through a resource
+func (c *FCApiClient) AddAppReceive(appID string, messageID string, resourceID string) (*AppMessageAPIResponse, error) {
+	return c.addAppMessage(appID, "receives", messageID, resourceID)
+}
+
+// RemoveAppSend removes a message sent by an app
+func (c *FCApiClient) RemoveAppSend(appID string, sendID string) error {
+	return c.removeAppMessage(appID, "sends", sendID)
+}
+
+// RemoveAppReceive removes a message received by an app
+func (c *FCApiClient) RemoveAppReceive(appID string, receiveID string) error {
+	return c.removeAppMessage(appID, "receives", receiveID)
+}
+
+func (c *FCApiClient) addAppMessage(appID string, direction string, messageID string, resourceID string) (*AppMessageAPIResponse, error) {
+	// Prepare request body
+	reqBody := struct {
+		MessageID  string `json:"message_id"`
+		ResourceID string `json:"resource_id"`
+	}{
+		MessageID:  messageID,
+		ResourceID: resourceID,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/apps/%s/%s", c.host, appID, direction)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var message AppMessageAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&message); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return &message, nil
+}
+
+func (c *FCApiClient) removeAppMessage(appID string, direction string, id string) error {
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/apps/%s/%s/%s", c.host, appID, direction, id)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+func (c *FCApiClient) ListServerBinds(serverID string) ([]contracts.ServerBind, error) {
+	url := fmt.Sprintf("%sv1/protected/servers/%s/binds", c.host, serverID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to execute request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var binds []contracts.ServerBind
+	if err := json.Unmarshal(bodyBytes, &binds); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return binds, nil
+}
+
+func (c *FCApiClient) CreateServerBind(serverID string, bind contracts.ServerBind) (*contracts.ServerBind, error) {
+	jsonData, err := json.Marshal(bind)
+	if err != nil {
+		return nil, errors.New("failed to marshal bind data: " + err.Error())
+	}
+
+	url := fmt.Sprintf("%sv1/protected/servers/%s/binds", c.host, serverID)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to execute request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var result contracts.ServerBind
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &result, nil
+}
+
+func (c *FCApiClient) DeleteServerBind(serverID string, bindID string) error {
+	url := fmt.Sprintf("%sv1/protected/servers/%s/binds/%s", c.host, serverID, bindID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.New("failed to execute request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	return nil
+}
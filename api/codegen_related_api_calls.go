@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
 // GenerateAppCode generates code for an application in the specified language
@@ -52,3 +53,42 @@ func (c *FCApiClient) GenerateAppCode(appID string, language string) (string, er
 
 	return string(code), nil
 }
+
+// DownloadCodeArtifact downloads a generated file. Artifact URLs may be relative to the API host,
+// the access token is only sent to the API host and not to storage URLs of other hosts.
+func (c *FCApiClient) DownloadCodeArtifact(artifactURL string) ([]byte, error) {
+	url := artifactURL
+	if !strings.Contains(url, "://") {
+		url = c.host + strings.TrimPrefix(url, "/")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	if strings.HasPrefix(url, c.host) {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	return content, nil
+}
@@ -7,14 +7,19 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/fusioncatalyst/paw/contracts"
 )
 
 // MessageAPIResponse represents the response from the API for message-related endpoints
 type MessageAPIResponse struct {
+	ID            string `json:"id"`
 	Description   string `json:"description"`
 	Name          string `json:"name"`
 	SchemaID      string `json:"schema_id"`
 	SchemaVersion int    `json:"schema_version"`
+	// CloudEvents is set for messages which travel inside a CloudEvents envelope
+	CloudEvents *contracts.CloudEventAttributes `json:"cloudevents,omitempty"`
 }
 
 // ListMessages retrieves a list of messages for a specific project
@@ -53,18 +58,20 @@ func (c *FCApiClient) ListMessages(projectID string) ([]MessageAPIResponse, erro
 }
 
 // CreateMessage creates a new message in the specified project
-func (c *FCApiClient) CreateMessage(projectID string, name string, description string, schemaID string, schemaVersion int64) (*MessageAPIResponse, error) {
+func (c *FCApiClient) CreateMessage(projectID string, name string, description string, schemaID string, schemaVersion int64, cloudEvents *contracts.CloudEventAttributes) (*MessageAPIResponse, error) {
 	// Prepare request body
 	reqBody := struct {
-		Name          string `json:"name"`
-		Description   string `json:"description,omitempty"`
-		SchemaID      string `json:"schema_id"`
-		SchemaVersion int64  `json:"schema_version"`
+		Name          string                          `json:"name"`
+		Description   string                          `json:"description,omitempty"`
+		SchemaID      string                          `json:"schema_id"`
+		SchemaVersion int64                           `json:"schema_version"`
+		CloudEvents   *contracts.CloudEventAttributes `json:"cloudevents,omitempty"`
 	}{
 		Name:          name,
 		Description:   description,
 		SchemaID:      schemaID,
 		SchemaVersion: schemaVersion,
+		CloudEvents:   cloudEvents,
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -105,3 +112,56 @@ func (c *FCApiClient) CreateMessage(projectID string, name string, description s
 
 	return &message, nil
 }
+
+// UpdateMessage updates the description of a message, the schema version it is pinned to and/or
+// its CloudEvents attributes. Empty description, zero schema version and nil attributes are left unchanged.
+func (c *FCApiClient) UpdateMessage(messageID string, description string, schemaVersion int64, cloudEvents *contracts.CloudEventAttributes) (*MessageAPIResponse, error) {
+	// Prepare request body
+	reqBody := struct {
+		Description   string                          `json:"description,omitempty"`
+		SchemaVersion int64                           `json:"schema_version,omitempty"`
+		CloudEvents   *contracts.CloudEventAttributes `json:"cloudevents,omitempty"`
+	}{
+		Description:   description,
+		SchemaVersion: schemaVersion,
+		CloudEvents:   cloudEvents,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/messages/%s", c.host, messageID)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var message MessageAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&message); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return &message, nil
+}
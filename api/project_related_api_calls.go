@@ -11,9 +11,22 @@ import (
 )
 
 type ProjectAPIResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	IsPrivate     bool   `json:"is_private"`
+	Status        string `json:"status,omitempty"`
+	CreatedByType string `json:"created_by_type,omitempty"`
+	CreatedByID   string `json:"created_by_id,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+}
+
+// UpdateProjectRequest changes the given fields of a project, empty fields are left unchanged
+type UpdateProjectRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IsPrivate   *bool  `json:"is_private,omitempty"`
 }
 
 func (c *FCApiClient) ListProjects() ([]ProjectAPIResponse, error) {
@@ -111,6 +124,197 @@ func (c *FCApiClient) CreateProject(
 	return &project, nil
 }
 
+// GetProject retrieves a single project by its ID
+func (c *FCApiClient) GetProject(projectID string) (*ProjectAPIResponse, error) {
+	url := fmt.Sprintf("%sv1/protected/projects/%s", c.host, projectID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var project ProjectAPIResponse
+	if err := json.Unmarshal(bodyBytes, &project); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &project, nil
+}
+
+// UpdateProject changes the name, description or privacy of a project
+func (c *FCApiClient) UpdateProject(projectID string, project UpdateProjectRequest) (*ProjectAPIResponse, error) {
+	jsonData, err := json.Marshal(project)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	url := fmt.Sprintf("%sv1/protected/projects/%s", c.host, projectID)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var updated ProjectAPIResponse
+	if err := json.Unmarshal(bodyBytes, &updated); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &updated, nil
+}
+
+// ArchiveProject archives a project, archived projects are kept but can no longer be changed
+func (c *FCApiClient) ArchiveProject(projectID string) (*ProjectAPIResponse, error) {
+	url := fmt.Sprintf("%sv1/protected/projects/%s/archive", c.host, projectID)
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var project ProjectAPIResponse
+	if err := json.Unmarshal(bodyBytes, &project); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &project, nil
+}
+
+// TransferProject moves a project to another owner, ownerType is either "user" or "workspace"
+func (c *FCApiClient) TransferProject(projectID string, ownerType string, ownerID string) (*ProjectAPIResponse, error) {
+	reqBody := struct {
+		CreatedByType string `json:"created_by_type"`
+		CreatedByID   string `json:"created_by_id"`
+	}{
+		CreatedByType: ownerType,
+		CreatedByID:   ownerID,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	url := fmt.Sprintf("%sv1/protected/projects/%s/transfer", c.host, projectID)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var project ProjectAPIResponse
+	if err := json.Unmarshal(bodyBytes, &project); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &project, nil
+}
+
+// DeleteProject deletes a project with all its servers, schemas, messages and apps
+func (c *FCApiClient) DeleteProject(projectID string) error {
+	url := fmt.Sprintf("%sv1/protected/projects/%s", c.host, projectID)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	return nil
+}
+
 // ImportProject uploads a file to the specified project and processes the import
 func (c *FCApiClient) ImportProject(projectID string, filePath string) error {
 	// First, verify and read the file
@@ -119,11 +323,16 @@ func (c *FCApiClient) ImportProject(projectID string, filePath string) error {
 		return errors.New("failed to read file: " + err.Error())
 	}
 
+	return c.ImportProjectDefinition(projectID, fileContent)
+}
+
+// ImportProjectDefinition imports the content of a project definition into the specified project
+func (c *FCApiClient) ImportProjectDefinition(projectID string, content []byte) error {
 	// Create request body with file content as text
 	reqBody := struct {
 		YAML string `json:"yaml"`
 	}{
-		YAML: string(fileContent),
+		YAML: string(content),
 	}
 
 	// Marshal the request body to JSON
@@ -167,8 +376,37 @@ func (c *FCApiClient) ImportProject(projectID string, filePath string) error {
 	return nil
 }
 
-// GenerateCode generates code for a specific application in a project
-func (c *FCApiClient) GenerateCode(projectID string, appID string) (*ProjectAPIResponse, error) {
+// Statuses of code generations
+const (
+	CodeGenerationPending   = "pending"
+	CodeGenerationRunning   = "running"
+	CodeGenerationCompleted = "completed"
+	CodeGenerationFailed    = "failed"
+)
+
+// CodeGenerationAPIResponse is a code generation job of an app. Generations which are not
+// finished yet have no artifacts, their status is polled with GetCodeGeneration.
+type CodeGenerationAPIResponse struct {
+	ID        string                    `json:"id"`
+	ProjectID string                    `json:"project_id"`
+	AppID     string                    `json:"app_id"`
+	Status    string                    `json:"status"`
+	Error     string                    `json:"error,omitempty"`
+	Artifacts []CodeArtifactAPIResponse `json:"artifacts"`
+	CreatedAt string                    `json:"created_at,omitempty"`
+	UpdatedAt string                    `json:"updated_at,omitempty"`
+}
+
+// CodeArtifactAPIResponse is a generated file, Path is relative to the output directory
+type CodeArtifactAPIResponse struct {
+	Path string `json:"path"`
+	URL  string `json:"url"`
+	Size int64  `json:"size"`
+}
+
+// GenerateCode starts the code generation for a specific application in a project. Generations
+// which run asynchronously are answered with 202 Accepted.
+func (c *FCApiClient) GenerateCode(projectID string, appID string) (*CodeGenerationAPIResponse, error) {
 	url := fmt.Sprintf("%sv1/protected/projects/%s/apps/%s/generate", c.host, projectID, appID)
 	req, err := http.NewRequest(http.MethodPost, url, nil)
 	if err != nil {
@@ -188,6 +426,42 @@ func (c *FCApiClient) GenerateCode(projectID string, appID string) (*ProjectAPIR
 		return nil, errors.New("failed to read response body: " + err.Error())
 	}
 
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var generation CodeGenerationAPIResponse
+	if err := json.Unmarshal(bodyBytes, &generation); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &generation, nil
+}
+
+// GetCodeGeneration retrieves the current state of a code generation
+func (c *FCApiClient) GetCodeGeneration(projectID string, appID string, generationID string) (*CodeGenerationAPIResponse, error) {
+	url := fmt.Sprintf("%sv1/protected/projects/%s/apps/%s/generations/%s", c.host, projectID, appID, generationID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, &APIError{
 			StatusCode: resp.StatusCode,
@@ -195,10 +469,10 @@ func (c *FCApiClient) GenerateCode(projectID string, appID string) (*ProjectAPIR
 		}
 	}
 
-	var project ProjectAPIResponse
-	if err := json.Unmarshal(bodyBytes, &project); err != nil {
+	var generation CodeGenerationAPIResponse
+	if err := json.Unmarshal(bodyBytes, &generation); err != nil {
 		return nil, errors.New("failed to parse response: " + err.Error())
 	}
 
-	return &project, nil
+	return &generation, nil
 }
@@ -89,4 +89,108 @@ func (c *FCApiClient) CreateResource(serverID string, resource contracts.CreateR
 	}
 
 	return &newResource, nil
-}
\ No newline at end of file
+}
+func (c *FCApiClient) GetResource(resourceID string) (*contracts.ResourceResponse, error) {
+	url := fmt.Sprintf("%sv1/protected/resources/%s", c.host, resourceID)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var resource contracts.ResourceResponse
+	if err := json.Unmarshal(bodyBytes, &resource); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &resource, nil
+}
+
+func (c *FCApiClient) UpdateResource(resourceID string, resource contracts.UpdateResourceRequest) (*contracts.ResourceResponse, error) {
+	url := fmt.Sprintf("%sv1/protected/resources/%s", c.host, resourceID)
+
+	jsonData, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var updatedResource contracts.ResourceResponse
+	if err := json.Unmarshal(bodyBytes, &updatedResource); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &updatedResource, nil
+}
+
+func (c *FCApiClient) DeleteResource(resourceID string) error {
+	url := fmt.Sprintf("%sv1/protected/resources/%s", c.host, resourceID)
+
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	return nil
+}
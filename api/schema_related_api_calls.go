@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
 )
 
 // SchemaAPIResponse represents the response from the API for schema-related endpoints
@@ -14,6 +17,7 @@ type SchemaAPIResponse struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	Type        string `json:"type"`
 	ProjectID   string `json:"project_id"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
@@ -67,16 +71,10 @@ func (c *FCApiClient) ListSchemas(projectID string) ([]SchemaAPIResponse, error)
 
 // CreateSchema creates a new schema in the specified project
 func (c *FCApiClient) CreateSchema(projectID string, name string, description string, schemaType string, schemaContent string) (*SchemaAPIResponse, error) {
-	// First, validate that the schema content is valid JSON
-	var schemaJSON interface{}
-	if err := json.Unmarshal([]byte(schemaContent), &schemaJSON); err != nil {
-		return nil, errors.New("invalid schema content: " + err.Error())
-	}
-
-	// Re-marshal the schema to ensure it's properly escaped
-	escapedSchema, err := json.Marshal(schemaJSON)
+	// First, validate the schema content according to its type
+	escapedSchema, err := schemas.Normalize(contracts.SchemaType(schemaType), schemaContent)
 	if err != nil {
-		return nil, errors.New("failed to escape schema content: " + err.Error())
+		return nil, err
 	}
 
 	// Prepare request body
@@ -89,7 +87,7 @@ func (c *FCApiClient) CreateSchema(projectID string, name string, description st
 		Name:        name,
 		Description: description,
 		Type:        schemaType,
-		Schema:      string(escapedSchema),
+		Schema:      escapedSchema,
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -132,24 +130,18 @@ func (c *FCApiClient) CreateSchema(projectID string, name string, description st
 }
 
 // UpdateSchema updates an existing schema
-func (c *FCApiClient) UpdateSchema(schemaID string, schemaContent string) (*SchemaAPIResponse, error) {
-	// First, validate that the schema content is valid JSON
-	var schemaJSON interface{}
-	if err := json.Unmarshal([]byte(schemaContent), &schemaJSON); err != nil {
-		return nil, errors.New("invalid schema content: " + err.Error())
-	}
-
-	// Re-marshal the schema to ensure it's properly escaped
-	escapedSchema, err := json.Marshal(schemaJSON)
+func (c *FCApiClient) UpdateSchema(schemaID string, schemaType string, schemaContent string) (*SchemaAPIResponse, error) {
+	// First, validate the schema content according to its type
+	escapedSchema, err := schemas.Normalize(contracts.SchemaType(schemaType), schemaContent)
 	if err != nil {
-		return nil, errors.New("failed to escape schema content: " + err.Error())
+		return nil, err
 	}
 
 	// Prepare request body
 	reqBody := struct {
 		Schema string `json:"schema"`
 	}{
-		Schema: string(escapedSchema),
+		Schema: escapedSchema,
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -260,3 +252,41 @@ func (c *FCApiClient) GetSchemaVersion(schemaID string, versionID string) (*Sche
 
 	return &version, nil
 }
+
+// GetSchemaVersionByNumber retrieves the version of a schema with the given version number.
+// The version number is resolved through the list of schema versions.
+func (c *FCApiClient) GetSchemaVersionByNumber(schemaID string, versionNumber int) (*SchemaVersionAPIResponse, error) {
+	versions, err := c.ListSchemaVersions(schemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range versions {
+		if version.Version == versionNumber {
+			return &version, nil
+		}
+	}
+
+	return nil, fmt.Errorf("version %d not found for schema %s", versionNumber, schemaID)
+}
+
+// GetLatestSchemaVersion retrieves the version of a schema with the highest version number
+func (c *FCApiClient) GetLatestSchemaVersion(schemaID string) (*SchemaVersionAPIResponse, error) {
+	versions, err := c.ListSchemaVersions(schemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("schema %s has no versions", schemaID)
+	}
+
+	latest := versions[0]
+	for _, version := range versions[1:] {
+		if version.Version > latest.Version {
+			latest = version
+		}
+	}
+
+	return &latest, nil
+}
@@ -109,3 +109,111 @@ func (c *FCApiClient) ListServers(projectID string) (*contracts.ServersListRespo
 }
 
 
+
+func (c *FCApiClient) GetServer(serverID string) (*contracts.Server, error) {
+	if serverID == "" {
+		return nil, errors.New("server ID is required")
+	}
+
+	url := fmt.Sprintf("%sv1/protected/servers/%s", c.host, serverID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to execute request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var server contracts.Server
+	if err := json.Unmarshal(bodyBytes, &server); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &server, nil
+}
+
+func (c *FCApiClient) UpdateServer(serverID string, server *contracts.UpdateServerRequest) (*contracts.Server, error) {
+	jsonData, err := json.Marshal(server)
+	if err != nil {
+		return nil, errors.New("failed to marshal server data: " + err.Error())
+	}
+
+	url := fmt.Sprintf("%sv1/protected/servers/%s", c.host, serverID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to execute request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("failed to read response body: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	var result contracts.Server
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return nil, errors.New("failed to parse response: " + err.Error())
+	}
+
+	return &result, nil
+}
+
+func (c *FCApiClient) DeleteServer(serverID string) error {
+	url := fmt.Sprintf("%sv1/protected/servers/%s", c.host, serverID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.New("failed to execute request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	return nil
+}
@@ -109,3 +109,387 @@ func (c *FCApiClient) CreateWorkspace(name string, description string) (*Workspa
 
 	return &workspace, nil
 }
+
+// WorkspaceRoles lists the roles a member can have in a workspace
+var WorkspaceRoles = []string{"owner", "admin", "member"}
+
+// WorkspaceMemberAPIResponse represents a user who is a member of a workspace
+type WorkspaceMemberAPIResponse struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Name      string `json:"name,omitempty"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// WorkspaceInvitationAPIResponse represents an invitation of an email address to a workspace
+type WorkspaceInvitationAPIResponse struct {
+	ID          string `json:"id"`
+	WorkspaceID string `json:"workspace_id"`
+	Email       string `json:"email"`
+	Role        string `json:"role"`
+	Status      string `json:"status"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+}
+
+// GetWorkspace retrieves a single workspace by its ID
+func (c *FCApiClient) GetWorkspace(workspaceID string) (*WorkspaceAPIResponse, error) {
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s", c.host, workspaceID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var workspace WorkspaceAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&workspace); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return &workspace, nil
+}
+
+// UpdateWorkspace changes the name or description of a workspace, empty values are left unchanged
+func (c *FCApiClient) UpdateWorkspace(workspaceID string, name string, description string) (*WorkspaceAPIResponse, error) {
+	// Prepare request body
+	reqBody := struct {
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+	}{
+		Name:        name,
+		Description: description,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s", c.host, workspaceID)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var workspace WorkspaceAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&workspace); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return &workspace, nil
+}
+
+// DeleteWorkspace deletes a workspace
+func (c *FCApiClient) DeleteWorkspace(workspaceID string) error {
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s", c.host, workspaceID)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	return nil
+}
+
+// ListWorkspaceProjects retrieves the projects which belong to a workspace
+func (c *FCApiClient) ListWorkspaceProjects(workspaceID string) ([]ProjectAPIResponse, error) {
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s/projects", c.host, workspaceID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var projects []ProjectAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&projects); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return projects, nil
+}
+
+// ListWorkspaceMembers retrieves the members of a workspace with their roles
+func (c *FCApiClient) ListWorkspaceMembers(workspaceID string) ([]WorkspaceMemberAPIResponse, error) {
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s/members", c.host, workspaceID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var members []WorkspaceMemberAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return members, nil
+}
+
+// AddWorkspaceMember adds the user with the given email address to a workspace
+func (c *FCApiClient) AddWorkspaceMember(workspaceID string, email string, role string) (*WorkspaceMemberAPIResponse, error) {
+	// Prepare request body
+	reqBody := struct {
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}{
+		Email: email,
+		Role:  role,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s/members", c.host, workspaceID)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var member WorkspaceMemberAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return &member, nil
+}
+
+// SetWorkspaceMemberRole changes the role of a member of a workspace
+func (c *FCApiClient) SetWorkspaceMemberRole(workspaceID string, userID string, role string) (*WorkspaceMemberAPIResponse, error) {
+	// Prepare request body
+	reqBody := struct {
+		Role string `json:"role"`
+	}{
+		Role: role,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s/members/%s", c.host, workspaceID, userID)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var member WorkspaceMemberAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return &member, nil
+}
+
+// RemoveWorkspaceMember removes a member from a workspace
+func (c *FCApiClient) RemoveWorkspaceMember(workspaceID string, userID string) error {
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s/members/%s", c.host, workspaceID, userID)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	return nil
+}
+
+// InviteToWorkspace invites an email address to a workspace, the invitation is sent by email
+// and works for people who do not have an account yet
+func (c *FCApiClient) InviteToWorkspace(workspaceID string, email string, role string) (*WorkspaceInvitationAPIResponse, error) {
+	// Prepare request body
+	reqBody := struct {
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}{
+		Email: email,
+		Role:  role,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.New("failed to marshal request: " + err.Error())
+	}
+
+	// Make API request
+	url := fmt.Sprintf("%sv1/protected/workspaces/%s/invitations", c.host, workspaceID)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, errors.New("failed to create request: " + err.Error())
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.GetAuthorization()))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.New("failed to send request: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(bodyBytes),
+		}
+	}
+
+	// Read and parse response body
+	var invitation WorkspaceInvitationAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&invitation); err != nil {
+		return nil, errors.New("failed to decode response: " + err.Error())
+	}
+
+	return &invitation, nil
+}
@@ -0,0 +1,128 @@
+package asyncapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	Version2 = "2.6.0"
+	Version3 = "3.0.0"
+)
+
+// Versions lists the AsyncAPI versions paw can produce
+var Versions = []string{Version2, Version3}
+
+// Document is an AsyncAPI document. Only the parts of the specification paw produces are
+// modelled, fields which exist in a single version only are left empty in the other one.
+type Document struct {
+	AsyncAPI           string               `json:"asyncapi" yaml:"asyncapi"`
+	Info               Info                 `json:"info" yaml:"info"`
+	DefaultContentType string               `json:"defaultContentType,omitempty" yaml:"defaultContentType,omitempty"`
+	Servers            map[string]Server    `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Channels           map[string]Channel   `json:"channels" yaml:"channels"`
+	Operations         map[string]Operation `json:"operations,omitempty" yaml:"operations,omitempty"`
+	Components         Components           `json:"components,omitempty" yaml:"components,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title" yaml:"title"`
+	Version     string `json:"version" yaml:"version"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type Server struct {
+	// Host is used by AsyncAPI 3.0, URL by AsyncAPI 2.x
+	Host        string `json:"host,omitempty" yaml:"host,omitempty"`
+	URL         string `json:"url,omitempty" yaml:"url,omitempty"`
+	Protocol    string `json:"protocol" yaml:"protocol"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type Channel struct {
+	Address     string `json:"address,omitempty" yaml:"address,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Servers holds references in AsyncAPI 3.0 and server names in AsyncAPI 2.x
+	Servers  []interface{}          `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Messages map[string]Reference   `json:"messages,omitempty" yaml:"messages,omitempty"`
+	Bindings map[string]interface{} `json:"bindings,omitempty" yaml:"bindings,omitempty"`
+	// Publish and Subscribe are the operations of AsyncAPI 2.x channels
+	Publish   *ChannelOperation `json:"publish,omitempty" yaml:"publish,omitempty"`
+	Subscribe *ChannelOperation `json:"subscribe,omitempty" yaml:"subscribe,omitempty"`
+}
+
+// ChannelOperation is an operation of an AsyncAPI 2.x channel
+type ChannelOperation struct {
+	OperationID string      `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Summary     string      `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Message     interface{} `json:"message" yaml:"message"`
+}
+
+// Operation is an operation of AsyncAPI 3.0
+type Operation struct {
+	Action   string      `json:"action" yaml:"action"`
+	Channel  Reference   `json:"channel" yaml:"channel"`
+	Summary  string      `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Messages []Reference `json:"messages,omitempty" yaml:"messages,omitempty"`
+	// App is the paw app performing the operation, so documents of whole projects can be imported again
+	App string `json:"x-paw-app,omitempty" yaml:"x-paw-app,omitempty"`
+}
+
+type Components struct {
+	Messages map[string]Message     `json:"messages,omitempty" yaml:"messages,omitempty"`
+	Schemas  map[string]interface{} `json:"schemas,omitempty" yaml:"schemas,omitempty"`
+}
+
+type Message struct {
+	Name        string `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	ContentType string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	// SchemaFormat is only used by AsyncAPI 2.x, AsyncAPI 3.0 puts it into the payload
+	SchemaFormat string      `json:"schemaFormat,omitempty" yaml:"schemaFormat,omitempty"`
+	Payload      interface{} `json:"payload,omitempty" yaml:"payload,omitempty"`
+}
+
+type Reference struct {
+	Ref string `json:"$ref" yaml:"$ref"`
+}
+
+// oneOf lists the messages of an AsyncAPI 2.x operation
+type oneOf struct {
+	OneOf []Reference `json:"oneOf" yaml:"oneOf"`
+}
+
+// Marshal converts a document into YAML or JSON
+func Marshal(document *Document, format string) ([]byte, error) {
+	switch format {
+	case "yaml":
+		data, err := yaml.Marshal(document)
+		if err != nil {
+			return nil, errors.New("failed to marshal AsyncAPI document: " + err.Error())
+		}
+		return data, nil
+	case "json":
+		data, err := json.MarshalIndent(document, "", "  ")
+		if err != nil {
+			return nil, errors.New("failed to marshal AsyncAPI document: " + err.Error())
+		}
+		return append(data, '\n'), nil
+	}
+	return nil, fmt.Errorf("unsupported format %q. Must be one of: yaml, json", format)
+}
+
+// componentKey turns a name into a key allowed for components, channels and operations
+func componentKey(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
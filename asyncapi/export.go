@@ -0,0 +1,386 @@
+package asyncapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/cloudevents"
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+// Options select what is exported
+type Options struct {
+	Title string
+	// Version is the AsyncAPI version of the document, Version3 when empty
+	Version string
+	// App limits the document to a single app and to what it sends and receives
+	App string
+}
+
+// flow is an app sending or receiving a message through a resource
+type flow struct {
+	App     string
+	Message string
+	URI     *contracts.ResourceURI
+	Sends   bool
+}
+
+// Export converts a provision document into an AsyncAPI document. Servers become servers,
+// resources become channels, messages and their schemas become components and the sends
+// and receives of apps become operations.
+func Export(document *contracts.ProvisionYAMLFile, options Options) (*Document, error) {
+	if options.Version == "" {
+		options.Version = Version3
+	}
+	if options.Version != Version2 && options.Version != Version3 {
+		return nil, fmt.Errorf("unsupported AsyncAPI version %q. Must be one of: %s", options.Version, strings.Join(Versions, ", "))
+	}
+	v3 := options.Version == Version3
+
+	apps := document.Apps
+	if options.App != "" {
+		apps = nil
+		for _, app := range document.Apps {
+			if app.Name == options.App {
+				apps = append(apps, app)
+			}
+		}
+		if len(apps) == 0 {
+			return nil, fmt.Errorf("app %q not found", options.App)
+		}
+	}
+
+	var flows []flow
+	for _, app := range apps {
+		for _, entries := range []struct {
+			list  []contracts.ProvisionAppMessage
+			sends bool
+		}{{app.Sends, true}, {app.Receives, false}} {
+			for _, entry := range entries.list {
+				uri, err := contracts.ParseResourceURI(entry.Resource)
+				if err != nil {
+					return nil, fmt.Errorf("app %s: %s", app.Name, err)
+				}
+				flows = append(flows, flow{App: app.Name, Message: entry.Message, URI: uri, Sends: entries.sends})
+			}
+		}
+	}
+
+	// A project document describes everything, an app document only what the app uses
+	usedResources := map[string]bool{}
+	usedMessages := map[string]bool{}
+	for _, f := range flows {
+		usedResources[f.URI.Server+"/"+f.URI.Name] = true
+		usedMessages[f.Message] = true
+	}
+	includeResource := func(server, resource string) bool {
+		return options.App == "" || usedResources[server+"/"+resource]
+	}
+	includeMessage := func(message string) bool {
+		return options.App == "" || usedMessages[message]
+	}
+
+	title := options.Title
+	if title == "" {
+		title = "paw project"
+	}
+	result := &Document{
+		AsyncAPI:           options.Version,
+		Info:               Info{Title: title, Version: "1.0.0"},
+		DefaultContentType: "application/json",
+		Servers:            map[string]Server{},
+		Channels:           map[string]Channel{},
+		Components: Components{
+			Messages: map[string]Message{},
+			Schemas:  map[string]interface{}{},
+		},
+	}
+	if options.App != "" {
+		result.Info.Description = apps[0].Description
+	}
+
+	// AsyncAPI 2.x channels are keyed by their address, which may be used by several servers
+	resourceServers := map[string]int{}
+	for _, server := range document.Servers {
+		for _, resource := range server.Resources {
+			resourceServers[resource.Name]++
+		}
+	}
+	channelKey := func(server, resource string) string {
+		if v3 {
+			return componentKey(server + "_" + resource)
+		}
+		if resourceServers[resource] > 1 {
+			return server + "/" + resource
+		}
+		return resource
+	}
+
+	for _, server := range document.Servers {
+		var channels []contracts.ServerResource
+		for _, resource := range server.Resources {
+			if includeResource(server.Name, resource.Name) {
+				channels = append(channels, resource)
+			}
+		}
+		if len(channels) == 0 && options.App != "" {
+			continue
+		}
+
+		serverKey := componentKey(server.Name)
+		exported := Server{Protocol: asyncAPIProtocol(server.Type), Description: server.Description}
+		if v3 {
+			exported.Host = server.Name
+		} else {
+			exported.URL = server.Name
+		}
+		result.Servers[serverKey] = exported
+
+		for _, resource := range channels {
+			channel := Channel{
+				Description: resource.Description,
+				Bindings:    channelBindings(server.Type, resource),
+			}
+			if v3 {
+				channel.Address = resource.Name
+				if resource.ResourceName != "" {
+					channel.Address = resource.ResourceName
+				}
+				channel.Servers = []interface{}{Reference{Ref: "#/servers/" + serverKey}}
+			} else {
+				channel.Servers = []interface{}{serverKey}
+			}
+			result.Channels[channelKey(server.Name, resource.Name)] = channel
+		}
+	}
+
+	schemasByName := map[string]contracts.ProvisionSchema{}
+	for _, schema := range document.Schemas {
+		schemasByName[schema.Name] = schema
+	}
+	for _, message := range document.Messages {
+		if !includeMessage(message.Name) {
+			continue
+		}
+		schema, ok := schemasByName[message.Schema.Name]
+		if !ok {
+			return nil, fmt.Errorf("message %q references unknown schema %q", message.Name, message.Schema.Name)
+		}
+		exported, err := exportMessage(result, message, schema, v3)
+		if err != nil {
+			return nil, err
+		}
+		result.Components.Messages[componentKey(message.Name)] = exported
+	}
+
+	if v3 {
+		result.Operations = map[string]Operation{}
+	}
+	for _, f := range flows {
+		key := channelKey(f.URI.Server, f.URI.Name)
+		channel, ok := result.Channels[key]
+		if !ok {
+			return nil, fmt.Errorf("app %s: unknown resource %s", f.App, f.URI)
+		}
+		if _, ok := result.Components.Messages[componentKey(f.Message)]; !ok {
+			return nil, fmt.Errorf("app %s: unknown message %q", f.App, f.Message)
+		}
+		messageKey := componentKey(f.Message)
+
+		if v3 {
+			if channel.Messages == nil {
+				channel.Messages = map[string]Reference{}
+			}
+			channel.Messages[messageKey] = Reference{Ref: "#/components/messages/" + messageKey}
+
+			action, verb := "receive", "receives"
+			if f.Sends {
+				action, verb = "send", "sends"
+			}
+			operationKey := componentKey(f.App + "_" + action + "_" + f.Message)
+			if _, exists := result.Operations[operationKey]; exists {
+				operationKey += "_" + key
+			}
+			result.Operations[operationKey] = Operation{
+				Action:   action,
+				Channel:  Reference{Ref: "#/channels/" + key},
+				Summary:  fmt.Sprintf("%s %s %s through %s", f.App, verb, f.Message, f.URI.Name),
+				Messages: []Reference{{Ref: fmt.Sprintf("#/channels/%s/messages/%s", key, messageKey)}},
+				App:      f.App,
+			}
+		} else {
+			// In AsyncAPI 2.x subscribe describes what the application sends, publish what it receives
+			operation := channel.Publish
+			if f.Sends {
+				operation = channel.Subscribe
+			}
+			if operation == nil {
+				operation = &ChannelOperation{Message: oneOf{}}
+			}
+			addChannelOperationMessage(operation, f.App, Reference{Ref: "#/components/messages/" + messageKey})
+			if options.App != "" {
+				action := "receive"
+				if f.Sends {
+					action = "send"
+				}
+				operation.OperationID = componentKey(f.App + "_" + action + "_" + f.URI.Name)
+			}
+			if f.Sends {
+				channel.Subscribe = operation
+			} else {
+				channel.Publish = operation
+			}
+		}
+		result.Channels[key] = channel
+	}
+
+	if !v3 {
+		for key, channel := range result.Channels {
+			channel.Publish = simplifyChannelOperation(channel.Publish)
+			channel.Subscribe = simplifyChannelOperation(channel.Subscribe)
+			result.Channels[key] = channel
+		}
+	}
+
+	return result, nil
+}
+
+// exportMessage converts a message and its schema. JSON Schemas are shared through the
+// schema components, Avro and Protobuf schemas are embedded with their schema format.
+func exportMessage(result *Document, message contracts.ProvisionMessage, schema contracts.ProvisionSchema, v3 bool) (Message, error) {
+	exported := Message{Name: message.Name, Description: message.Description}
+	if message.CloudEvents != nil && schema.Type != contracts.SchemaTypeJSONSchema {
+		return exported, fmt.Errorf("message %q has a CloudEvents envelope, which is only supported for jsonschema schemas, schema %q is %s", message.Name, schema.Name, schema.Type)
+	}
+
+	switch schema.Type {
+	case contracts.SchemaTypeJSONSchema:
+		var content map[string]interface{}
+		if err := json.Unmarshal([]byte(schema.Schema), &content); err != nil {
+			return exported, fmt.Errorf("schema %q is not valid JSON: %s", schema.Name, err)
+		}
+		schemaKey := componentKey(schema.Name)
+		// $id and $schema would change how references inside the schema are resolved
+		delete(content, "$id")
+		delete(content, "$schema")
+		result.Components.Schemas[schemaKey] = rewriteLocalRefs(content, "#/components/schemas/"+schemaKey)
+		exported.Payload = Reference{Ref: "#/components/schemas/" + schemaKey}
+		// The schema describes the data of CloudEvents messages, the payload is the whole envelope
+		if message.CloudEvents != nil {
+			exported.ContentType = cloudevents.ContentType
+			exported.Payload = cloudevents.EnvelopeSchema(*message.CloudEvents, exported.Payload)
+		}
+		return exported, nil
+	case contracts.SchemaTypeAvro:
+		var content interface{}
+		if err := json.Unmarshal([]byte(schema.Schema), &content); err != nil {
+			return exported, fmt.Errorf("schema %q is not valid JSON: %s", schema.Name, err)
+		}
+		exported.ContentType = "application/octet-stream"
+		return withSchemaFormat(exported, "application/vnd.apache.avro;version=1.9.0", content, v3), nil
+	case contracts.SchemaTypeProtobuf:
+		exported.ContentType = "application/x-protobuf"
+		return withSchemaFormat(exported, "application/vnd.google.protobuf;version=3", schema.Schema, v3), nil
+	}
+	return exported, fmt.Errorf("schema %q has unsupported type %q", schema.Name, schema.Type)
+}
+
+func withSchemaFormat(message Message, schemaFormat string, schema interface{}, v3 bool) Message {
+	if v3 {
+		message.Payload = map[string]interface{}{
+			"schemaFormat": schemaFormat,
+			"schema":       schema,
+		}
+	} else {
+		message.SchemaFormat = schemaFormat
+		message.Payload = schema
+	}
+	return message
+}
+
+// rewriteLocalRefs points references within a schema to the location the schema is moved to
+func rewriteLocalRefs(node interface{}, prefix string) interface{} {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if ref, ok := child.(string); ok && key == "$ref" && strings.HasPrefix(ref, "#") {
+				value[key] = prefix + strings.TrimPrefix(ref, "#")
+				continue
+			}
+			value[key] = rewriteLocalRefs(child, prefix)
+		}
+	case []interface{}:
+		for i, child := range value {
+			value[i] = rewriteLocalRefs(child, prefix)
+		}
+	}
+	return node
+}
+
+func addChannelOperationMessage(operation *ChannelOperation, app string, message Reference) {
+	messages := operation.Message.(oneOf)
+	found := false
+	for _, existing := range messages.OneOf {
+		if existing == message {
+			found = true
+		}
+	}
+	if !found {
+		messages.OneOf = append(messages.OneOf, message)
+	}
+	operation.Message = messages
+
+	apps := strings.TrimPrefix(operation.Summary, "Used by ")
+	if operation.Summary == "" {
+		operation.Summary = "Used by " + app
+	} else if !containsString(strings.Split(apps, ", "), app) {
+		operation.Summary += ", " + app
+	}
+}
+
+// simplifyChannelOperation replaces a oneOf with a single message by the message itself
+func simplifyChannelOperation(operation *ChannelOperation) *ChannelOperation {
+	if operation == nil {
+		return nil
+	}
+	if messages, ok := operation.Message.(oneOf); ok && len(messages.OneOf) == 1 {
+		operation.Message = messages.OneOf[0]
+	}
+	return operation
+}
+
+func asyncAPIProtocol(protocol string) string {
+	if capabilities, ok := contracts.Protocols[protocol]; ok && capabilities.AsyncAPI != "" {
+		return capabilities.AsyncAPI
+	}
+	return strings.TrimPrefix(protocol, "async+")
+}
+
+// channelBindings describes how a resource maps onto the broker, for protocols which have bindings
+func channelBindings(protocol string, resource contracts.ServerResource) map[string]interface{} {
+	name := resource.Name
+	if resource.ResourceName != "" {
+		name = resource.ResourceName
+	}
+
+	switch asyncAPIProtocol(protocol) {
+	case "kafka":
+		return map[string]interface{}{"kafka": map[string]interface{}{"topic": name}}
+	case "amqp":
+		if contracts.ResourceType(resource.Type) == contracts.ResourceTypeQueue {
+			return map[string]interface{}{"amqp": map[string]interface{}{"is": "queue", "queue": map[string]interface{}{"name": name}}}
+		}
+		return map[string]interface{}{"amqp": map[string]interface{}{"is": "routingKey", "exchange": map[string]interface{}{"name": name}}}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,633 @@
+package asyncapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"gopkg.in/yaml.v3"
+)
+
+// protocolAliases maps secure and alternative AsyncAPI protocol names onto the ones paw knows
+var protocolAliases = map[string]string{
+	"kafka-secure": "kafka",
+	"amqps":        "amqp",
+	"secure-mqtt":  "mqtt",
+	"mqtts":        "mqtt",
+	"https":        "http",
+}
+
+type importer struct {
+	root        map[string]interface{}
+	v3          bool
+	document    *contracts.ProvisionYAMLFile
+	servers     map[string]int
+	schemas     map[string]bool
+	messages    map[string]bool
+	unsupported []string
+
+	// channels maps channel keys onto the URIs of the resources created for them
+	channels map[string][]string
+	// channelMessages maps channel keys and their message keys onto message names (AsyncAPI 3.0)
+	channelMessages map[string]map[string]string
+	// apps holds the described application, and the apps of operations with an x-paw-app extension
+	apps []contracts.ProvisionApp
+}
+
+// Import converts an AsyncAPI 2.x or 3.0 document into a project definition. Servers become
+// servers, channels become resources, messages and their payloads become messages and schemas
+// and the operations of the described application become an app. Constructs which cannot be
+// represented in a project definition are skipped and returned as the second result.
+func Import(data []byte) (*contracts.ProvisionYAMLFile, []string, error) {
+	var root map[string]interface{}
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, nil, errors.New("invalid AsyncAPI document: " + err.Error())
+	}
+
+	version := asString(root["asyncapi"])
+	if version == "" {
+		return nil, nil, errors.New("not an AsyncAPI document: the asyncapi field is missing")
+	}
+	if !strings.HasPrefix(version, "2.") && !strings.HasPrefix(version, "3.") {
+		return nil, nil, fmt.Errorf("unsupported AsyncAPI version %q. Must be 2.x or 3.x", version)
+	}
+
+	im := &importer{
+		root:            root,
+		v3:              strings.HasPrefix(version, "3."),
+		document:        &contracts.ProvisionYAMLFile{Version: 1},
+		servers:         map[string]int{},
+		schemas:         map[string]bool{},
+		messages:        map[string]bool{},
+		channels:        map[string][]string{},
+		channelMessages: map[string]map[string]string{},
+	}
+
+	info := asMap(root["info"])
+	im.apps = []contracts.ProvisionApp{{
+		Name:        snakeCase(asString(info["title"])),
+		Description: asString(info["description"]),
+	}}
+	if im.apps[0].Name == "" {
+		im.apps[0].Name = "app"
+	}
+
+	im.importServers()
+	im.importChannels()
+	if im.v3 {
+		im.importOperations()
+	}
+
+	// Messages which are only defined as components are still part of the contract
+	components := asMap(root["components"])
+	for _, key := range sortedKeys(asMap(components["messages"])) {
+		im.importMessage(map[string]interface{}{"$ref": "#/components/messages/" + key}, key)
+	}
+
+	for _, app := range im.apps {
+		if len(app.Sends) > 0 || len(app.Receives) > 0 {
+			im.document.Apps = append(im.document.Apps, app)
+		}
+	}
+
+	return im.document, im.unsupported, nil
+}
+
+func (im *importer) report(format string, args ...interface{}) {
+	im.unsupported = append(im.unsupported, fmt.Sprintf(format, args...))
+}
+
+func (im *importer) importServers() {
+	servers := asMap(im.root["servers"])
+	for _, key := range sortedKeys(servers) {
+		server, _, err := im.resolve(servers[key])
+		if err != nil {
+			im.report("server %q: %s", key, err)
+			continue
+		}
+
+		protocol := asString(server["protocol"])
+		serverType := pawProtocol(protocol)
+		if serverType == "" {
+			im.report("server %q: protocol %q is not supported, the server and its channels are skipped", key, protocol)
+			continue
+		}
+		if server["variables"] != nil {
+			im.report("server %q: server variables are not supported", key)
+		}
+		if server["security"] != nil {
+			im.report("server %q: security requirements are not supported", key)
+		}
+
+		im.servers[key] = len(im.document.Servers)
+		im.document.Servers = append(im.document.Servers, contracts.ProvisionServer{
+			Name:        componentKey(key),
+			Type:        serverType,
+			Description: asString(server["description"]),
+		})
+	}
+}
+
+func (im *importer) importChannels() {
+	channels := asMap(im.root["channels"])
+	for _, key := range sortedKeys(channels) {
+		channel, _, err := im.resolve(channels[key])
+		if err != nil {
+			im.report("channel %q: %s", key, err)
+			continue
+		}
+
+		address := key
+		if im.v3 {
+			address = asString(channel["address"])
+			if address == "" {
+				address = key
+			}
+		}
+		if strings.Contains(address, "{") {
+			im.report("channel %q: channel parameters are not supported, the channel is skipped", key)
+			continue
+		}
+
+		// A channel without servers is available on all servers
+		var serverKeys []string
+		for _, server := range asSlice(channel["servers"]) {
+			if im.v3 {
+				if ref := asString(asMap(server)["$ref"]); strings.HasPrefix(ref, "#/servers/") {
+					serverKeys = append(serverKeys, strings.TrimPrefix(ref, "#/servers/"))
+				}
+			} else {
+				serverKeys = append(serverKeys, asString(server))
+			}
+		}
+		if len(serverKeys) == 0 {
+			serverKeys = sortedKeys(im.servers)
+		}
+
+		for _, serverKey := range serverKeys {
+			index, ok := im.servers[serverKey]
+			if !ok {
+				continue
+			}
+			server := &im.document.Servers[index]
+			resource := contracts.ServerResource{
+				Name:         componentKey(address),
+				Mode:         string(contracts.ResourceModeReadWrite),
+				Type:         string(resourceType(server.Type, asMap(channel["bindings"]))),
+				Description:  asString(channel["description"]),
+				ResourceName: address,
+			}
+			server.Resources = append(server.Resources, resource)
+			im.channels[key] = append(im.channels[key], contracts.ResourceURI{
+				Protocol: server.Type,
+				Server:   server.Name,
+				Mode:     contracts.ResourceMode(resource.Mode),
+				Type:     contracts.ResourceType(resource.Type),
+				Name:     resource.Name,
+			}.String())
+		}
+		if len(im.channels[key]) == 0 {
+			im.report("channel %q: none of its servers could be imported, the channel and its messages are skipped", key)
+			continue
+		}
+
+		if im.v3 {
+			messages := asMap(channel["messages"])
+			im.channelMessages[key] = map[string]string{}
+			for _, messageKey := range sortedKeys(messages) {
+				if name, ok := im.importMessage(messages[messageKey], messageKey); ok {
+					im.channelMessages[key][messageKey] = name
+				}
+			}
+			continue
+		}
+
+		// In AsyncAPI 2.x subscribe describes what the application sends, publish what it receives
+		for _, operationKey := range []string{"subscribe", "publish"} {
+			operation := asMap(channel[operationKey])
+			if operation == nil {
+				continue
+			}
+			im.checkOperation(fmt.Sprintf("channel %q %s", key, operationKey), operation)
+
+			message := asMap(operation["message"])
+			candidates := []interface{}{message}
+			if oneOf, ok := message["oneOf"]; ok {
+				candidates = asSlice(oneOf)
+			}
+			for i, candidate := range candidates {
+				fallback := componentKey(key) + "_message"
+				if len(candidates) > 1 {
+					fallback = fmt.Sprintf("%s_%d", fallback, i+1)
+				}
+				if name, ok := im.importMessage(candidate, fallback); ok {
+					im.addFlow("", key, name, operationKey == "subscribe")
+				}
+			}
+		}
+	}
+}
+
+func (im *importer) importOperations() {
+	operations := asMap(im.root["operations"])
+	for _, key := range sortedKeys(operations) {
+		operation, _, err := im.resolve(operations[key])
+		if err != nil {
+			im.report("operation %q: %s", key, err)
+			continue
+		}
+		im.checkOperation(fmt.Sprintf("operation %q", key), operation)
+		if operation["reply"] != nil {
+			im.report("operation %q: replies are not supported", key)
+		}
+
+		channelKey := strings.TrimPrefix(asString(asMap(operation["channel"])["$ref"]), "#/channels/")
+		if _, ok := im.channels[channelKey]; !ok {
+			im.report("operation %q: channel %q was not imported, the operation is skipped", key, channelKey)
+			continue
+		}
+
+		// An operation without messages uses all messages of its channel
+		var names []string
+		for _, message := range asSlice(operation["messages"]) {
+			ref := asString(asMap(message)["$ref"])
+			messageKey := ref[strings.LastIndex(ref, "/")+1:]
+			if name, ok := im.channelMessages[channelKey][messageKey]; ok {
+				names = append(names, name)
+			}
+		}
+		if operation["messages"] == nil {
+			for _, messageKey := range sortedKeys(im.channelMessages[channelKey]) {
+				names = append(names, im.channelMessages[channelKey][messageKey])
+			}
+		}
+
+		for _, name := range names {
+			im.addFlow(asString(operation["x-paw-app"]), channelKey, name, asString(operation["action"]) == "send")
+		}
+	}
+}
+
+func (im *importer) checkOperation(description string, operation map[string]interface{}) {
+	if operation["traits"] != nil {
+		im.report("%s: operation traits are not supported", description)
+	}
+	if operation["security"] != nil {
+		im.report("%s: security requirements are not supported", description)
+	}
+}
+
+// addFlow adds a message sent or received through a channel to an app, the described
+// application is used when appName is empty
+func (im *importer) addFlow(appName string, channelKey string, message string, sends bool) {
+	app := &im.apps[0]
+	if appName != "" {
+		app = nil
+		for i := range im.apps {
+			if im.apps[i].Name == appName {
+				app = &im.apps[i]
+			}
+		}
+		if app == nil {
+			im.apps = append(im.apps, contracts.ProvisionApp{Name: appName})
+			app = &im.apps[len(im.apps)-1]
+		}
+	}
+
+	for _, uri := range im.channels[channelKey] {
+		entry := contracts.ProvisionAppMessage{Message: message, Resource: uri}
+		if sends && !containsAppMessage(app.Sends, entry) {
+			app.Sends = append(app.Sends, entry)
+		}
+		if !sends && !containsAppMessage(app.Receives, entry) {
+			app.Receives = append(app.Receives, entry)
+		}
+	}
+}
+
+// importMessage adds a message and its payload schema, it returns the name of the message
+// and whether it could be imported
+func (im *importer) importMessage(node interface{}, fallbackName string) (string, bool) {
+	message, ref, err := im.resolve(node)
+	if err != nil {
+		im.report("message %q: %s", fallbackName, err)
+		return "", false
+	}
+
+	name := asString(message["name"])
+	if strings.HasPrefix(ref, "#/components/messages/") {
+		name = strings.TrimPrefix(ref, "#/components/messages/")
+	}
+	if name == "" {
+		name = asString(message["messageId"])
+	}
+	if name == "" {
+		name = fallbackName
+	}
+	name = componentKey(name)
+	if im.messages[name] {
+		return name, true
+	}
+
+	for _, field := range []string{"headers", "correlationId", "traits", "bindings"} {
+		if message[field] != nil {
+			im.report("message %q: %s are not supported", name, field)
+		}
+	}
+
+	schemaFormat := asString(message["schemaFormat"])
+	payload := message["payload"]
+	if im.v3 {
+		if multiFormat := asMap(payload); multiFormat["schemaFormat"] != nil && multiFormat["schema"] != nil {
+			schemaFormat = asString(multiFormat["schemaFormat"])
+			payload = multiFormat["schema"]
+		}
+	}
+	if payload == nil {
+		im.report("message %q: messages without payload are not supported, the message is skipped", name)
+		return "", false
+	}
+
+	schema, err := im.importSchema(name, schemaFormat, payload)
+	if err != nil {
+		im.report("message %q: %s, the message is skipped", name, err)
+		return "", false
+	}
+
+	description := asString(message["description"])
+	if description == "" {
+		description = asString(message["summary"])
+	}
+	im.messages[name] = true
+	im.document.Messages = append(im.document.Messages, contracts.ProvisionMessage{
+		Name:        name,
+		Description: description,
+		Schema:      contracts.ProvisionMessageSchema{Name: schema, Version: 1},
+	})
+	return name, true
+}
+
+// importSchema adds the payload schema of a message and returns its name
+func (im *importer) importSchema(messageName string, schemaFormat string, payload interface{}) (string, error) {
+	var schemaType contracts.SchemaType
+	switch {
+	case schemaFormat == "" || strings.Contains(schemaFormat, "application/schema+") || strings.Contains(schemaFormat, "application/vnd.aai.asyncapi"):
+		schemaType = contracts.SchemaTypeJSONSchema
+	case strings.Contains(schemaFormat, "avro"):
+		schemaType = contracts.SchemaTypeAvro
+	case strings.Contains(schemaFormat, "protobuf"):
+		schemaType = contracts.SchemaTypeProtobuf
+	default:
+		return "", fmt.Errorf("schema format %q is not supported", schemaFormat)
+	}
+
+	name := messageName + "_payload"
+	ref := asString(asMap(payload)["$ref"])
+	if strings.HasPrefix(ref, "#/components/schemas/") {
+		name = strings.TrimPrefix(ref, "#/components/schemas/")
+	} else if ref != "" && !strings.HasPrefix(ref, "#") {
+		return "", fmt.Errorf("external reference %q is not supported", ref)
+	}
+
+	var content string
+	switch schemaType {
+	case contracts.SchemaTypeJSONSchema:
+		schema, err := im.inlineSchema(payload)
+		if err != nil {
+			return "", err
+		}
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		content = string(data) + "\n"
+	case contracts.SchemaTypeAvro:
+		if text, ok := payload.(string); ok {
+			content = text
+			break
+		}
+		resolved, _, err := im.resolve(payload)
+		if err != nil {
+			return "", err
+		}
+		data, err := json.MarshalIndent(resolved, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		content = string(data) + "\n"
+	case contracts.SchemaTypeProtobuf:
+		var ok bool
+		if content, ok = payload.(string); !ok {
+			return "", errors.New("protobuf payloads must be given as text")
+		}
+	}
+
+	name = componentKey(name)
+	if !im.schemas[name] {
+		im.schemas[name] = true
+		im.document.Schemas = append(im.document.Schemas, contracts.ProvisionSchema{
+			Name:    name,
+			Type:    schemaType,
+			Version: 1,
+			Schema:  content,
+		})
+	}
+	return name, nil
+}
+
+// inlineSchema makes a payload schema self-contained. References to other schema components
+// are moved into $defs, references to the schema itself point to its root.
+func (im *importer) inlineSchema(payload interface{}) (map[string]interface{}, error) {
+	self := ""
+	if ref := asString(asMap(payload)["$ref"]); strings.HasPrefix(ref, "#/components/schemas/") {
+		self = strings.TrimPrefix(ref, "#/components/schemas/")
+	}
+	resolved, _, err := im.resolve(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := deepCopy(resolved).(map[string]interface{})
+	defs := map[string]interface{}{}
+
+	var rewrite func(node interface{}) error
+	rewrite = func(node interface{}) error {
+		switch value := node.(type) {
+		case map[string]interface{}:
+			for key, child := range value {
+				ref, ok := child.(string)
+				if key != "$ref" || !ok {
+					if err := rewrite(child); err != nil {
+						return err
+					}
+					continue
+				}
+				if !strings.HasPrefix(ref, "#") {
+					return fmt.Errorf("external reference %q is not supported", ref)
+				}
+				if !strings.HasPrefix(ref, "#/components/schemas/") {
+					continue
+				}
+
+				target, rest, _ := strings.Cut(strings.TrimPrefix(ref, "#/components/schemas/"), "/")
+				if rest != "" {
+					rest = "/" + rest
+				}
+				if target == self {
+					value[key] = "#" + rest
+					continue
+				}
+				value[key] = "#/$defs/" + target + rest
+				if _, done := defs[target]; done {
+					continue
+				}
+				component, ok := asMap(asMap(im.root["components"])["schemas"])[target]
+				if !ok {
+					return fmt.Errorf("unknown schema component %q", target)
+				}
+				defs[target] = deepCopy(component)
+				if err := rewrite(defs[target]); err != nil {
+					return err
+				}
+			}
+		case []interface{}:
+			for _, child := range value {
+				if err := rewrite(child); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := rewrite(schema); err != nil {
+		return nil, err
+	}
+
+	if len(defs) > 0 {
+		existing := asMap(schema["$defs"])
+		if existing == nil {
+			existing = map[string]interface{}{}
+		}
+		for key, def := range defs {
+			existing[key] = def
+		}
+		schema["$defs"] = existing
+	}
+	return schema, nil
+}
+
+// resolve follows a reference within the document, it returns the referenced object and the reference
+func (im *importer) resolve(node interface{}) (map[string]interface{}, string, error) {
+	object := asMap(node)
+	ref := asString(object["$ref"])
+	if ref == "" {
+		return object, "", nil
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, ref, fmt.Errorf("external reference %q is not supported", ref)
+	}
+
+	var current interface{} = im.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		current = asMap(current)[token]
+	}
+	resolved := asMap(current)
+	if resolved == nil {
+		return nil, ref, fmt.Errorf("reference %q cannot be resolved", ref)
+	}
+	return resolved, ref, nil
+}
+
+// pawProtocol returns the server type of an AsyncAPI protocol, or an empty string if there is none
+func pawProtocol(protocol string) string {
+	if alias, ok := protocolAliases[protocol]; ok {
+		protocol = alias
+	}
+	for serverType, capabilities := range contracts.Protocols {
+		if capabilities.AsyncAPI == protocol {
+			return serverType
+		}
+	}
+	return ""
+}
+
+// resourceType picks the resource type of a channel, AMQP channels are exchanges unless bound to a queue
+func resourceType(serverType string, bindings map[string]interface{}) contracts.ResourceType {
+	capabilities := contracts.Protocols[serverType]
+	if capabilities.Binds {
+		if asString(asMap(bindings["amqp"])["is"]) == "queue" {
+			return contracts.ResourceTypeQueue
+		}
+		return contracts.ResourceTypeExchange
+	}
+	return capabilities.ResourceTypes[0]
+}
+
+func containsAppMessage(entries []contracts.ProvisionAppMessage, entry contracts.ProvisionAppMessage) bool {
+	for _, existing := range entries {
+		if existing == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// snakeCase turns a title like "User Service" into an app name like user_service
+func snakeCase(title string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(title)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+			b.WriteRune('_')
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+func deepCopy(node interface{}) interface{} {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(value))
+		for key, child := range value {
+			copied[key] = deepCopy(child)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(value))
+		for i, child := range value {
+			copied[i] = deepCopy(child)
+		}
+		return copied
+	}
+	return node
+}
+
+func asMap(node interface{}) map[string]interface{} {
+	value, _ := node.(map[string]interface{})
+	return value
+}
+
+func asSlice(node interface{}) []interface{} {
+	value, _ := node.([]interface{})
+	return value
+}
+
+func asString(node interface{}) string {
+	value, _ := node.(string)
+	return value
+}
+
+// sortedKeys returns the keys of a map in a stable order
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,143 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
+)
+
+const (
+	// SpecVersion is the version of the CloudEvents specification paw produces and accepts
+	SpecVersion = "1.0"
+	// ContentType is the media type of events in structured mode
+	ContentType = "application/cloudevents+json"
+	// DefaultSource is used by generated events of messages without a source attribute
+	DefaultSource = "urn:paw:sample"
+)
+
+// Wrap puts data into a structured mode CloudEvents envelope
+func Wrap(attributes contracts.CloudEventAttributes, id string, data interface{}) map[string]interface{} {
+	source := attributes.Source
+	if source == "" {
+		source = DefaultSource
+	}
+
+	envelope := map[string]interface{}{
+		"specversion":     SpecVersion,
+		"id":              id,
+		"source":          source,
+		"type":            attributes.Type,
+		"datacontenttype": "application/json",
+		"data":            data,
+	}
+	if attributes.DataSchema != "" {
+		envelope["dataschema"] = attributes.DataSchema
+	}
+	return envelope
+}
+
+// EnvelopeSchema returns a JSON Schema of structured mode envelopes of a message, the data
+// attribute is described by dataSchema
+func EnvelopeSchema(attributes contracts.CloudEventAttributes, dataSchema interface{}) map[string]interface{} {
+	source := map[string]interface{}{"type": "string", "minLength": 1}
+	if attributes.Source != "" {
+		source["const"] = attributes.Source
+	}
+	dataSchemaAttribute := map[string]interface{}{"type": "string", "format": "uri"}
+	if attributes.DataSchema != "" {
+		dataSchemaAttribute["const"] = attributes.DataSchema
+	}
+
+	return map[string]interface{}{
+		"type":     "object",
+		"required": []string{"specversion", "id", "source", "type", "data"},
+		"properties": map[string]interface{}{
+			"specversion":     map[string]interface{}{"type": "string", "const": SpecVersion},
+			"id":              map[string]interface{}{"type": "string", "minLength": 1},
+			"source":          source,
+			"type":            map[string]interface{}{"type": "string", "const": attributes.Type},
+			"subject":         map[string]interface{}{"type": "string"},
+			"time":            map[string]interface{}{"type": "string", "format": "date-time"},
+			"datacontenttype": map[string]interface{}{"type": "string"},
+			"dataschema":      dataSchemaAttribute,
+			"data":            dataSchema,
+		},
+	}
+}
+
+// Validator checks structured mode envelopes and validates their data against the message schema
+type Validator struct {
+	attributes contracts.CloudEventAttributes
+	data       *schemas.Validator
+}
+
+func NewValidator(attributes contracts.CloudEventAttributes, data *schemas.Validator) *Validator {
+	return &Validator{attributes: attributes, data: data}
+}
+
+// Validate returns all problems found in the envelope and in its data. Problems of the data
+// point into the data attribute, e.g. /data/email.
+func (v *Validator) Validate(document []byte) []schemas.DocumentError {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(document, &envelope); err != nil {
+		return []schemas.DocumentError{{Message: "not a CloudEvents envelope: " + err.Error()}}
+	}
+
+	var problems []schemas.DocumentError
+	attribute := func(name string) (string, bool) {
+		raw, ok := envelope[name]
+		if !ok {
+			return "", false
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			problems = append(problems, schemas.DocumentError{Pointer: "/" + name, Message: "must be a string"})
+			return "", false
+		}
+		return value, true
+	}
+	expect := func(name string, expected string) {
+		value, ok := attribute(name)
+		if ok && expected != "" && value != expected {
+			problems = append(problems, schemas.DocumentError{Pointer: "/" + name, Message: fmt.Sprintf("expected %q, got %q", expected, value)})
+		}
+	}
+
+	for _, name := range []string{"specversion", "id", "source", "type"} {
+		if _, ok := envelope[name]; !ok {
+			problems = append(problems, schemas.DocumentError{Message: fmt.Sprintf("missing required attribute %q", name)})
+		}
+	}
+	if id, ok := attribute("id"); ok && id == "" {
+		problems = append(problems, schemas.DocumentError{Pointer: "/id", Message: "must not be empty"})
+	}
+	expect("specversion", SpecVersion)
+	expect("type", v.attributes.Type)
+	expect("source", v.attributes.Source)
+	expect("dataschema", v.attributes.DataSchema)
+	if contentType, ok := attribute("datacontenttype"); ok && !isJSONContentType(contentType) {
+		problems = append(problems, schemas.DocumentError{Pointer: "/datacontenttype", Message: fmt.Sprintf("expected a JSON content type, got %q", contentType)})
+	}
+
+	data, ok := envelope["data"]
+	if !ok {
+		if _, encoded := envelope["data_base64"]; encoded {
+			return append(problems, schemas.DocumentError{Pointer: "/data_base64", Message: "binary data cannot be validated against the message schema"})
+		}
+		return append(problems, schemas.DocumentError{Message: "missing attribute \"data\""})
+	}
+	for _, problem := range v.data.Validate(data) {
+		problem.Pointer = "/data" + problem.Pointer
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,10 @@
+package contracts
+
+// CloudEventAttributes marks a message as travelling inside a CloudEvents envelope and holds
+// the context attributes every event of the message must carry. Empty attributes other than
+// Type are not checked.
+type CloudEventAttributes struct {
+	Type       string `json:"type" yaml:"type"`
+	Source     string `json:"source,omitempty" yaml:"source,omitempty"`
+	DataSchema string `json:"dataschema,omitempty" yaml:"dataschema,omitempty"`
+}
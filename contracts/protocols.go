@@ -0,0 +1,84 @@
+package contracts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProtocolCapabilities describes which resources a server protocol supports
+type ProtocolCapabilities struct {
+	ResourceTypes []ResourceType
+	ResourceModes []ResourceMode
+	// Binds is set for protocols which route messages from exchanges to queues
+	Binds bool
+	// AsyncAPI is the name of the protocol in AsyncAPI documents
+	AsyncAPI string
+}
+
+// Protocols is the capability table of all server protocols known to paw.
+// New protocols only need an entry here to be accepted by resource validation.
+var Protocols = map[string]ProtocolCapabilities{
+	"async+kafka": {
+		ResourceTypes: []ResourceType{ResourceTypeKafkaTopic},
+		ResourceModes: []ResourceMode{ResourceModeRead, ResourceModeWrite, ResourceModeReadWrite},
+		AsyncAPI:      "kafka",
+	},
+	"async+amqp": {
+		ResourceTypes: []ResourceType{ResourceTypeExchange, ResourceTypeQueue},
+		ResourceModes: []ResourceMode{ResourceModeRead, ResourceModeWrite, ResourceModeBind, ResourceModeReadWrite},
+		Binds:         true,
+		AsyncAPI:      "amqp",
+	},
+	"async+webhook": {
+		ResourceTypes: []ResourceType{ResourceTypeEndpoint},
+		ResourceModes: []ResourceMode{ResourceModeRead, ResourceModeWrite, ResourceModeReadWrite},
+		AsyncAPI:      "http",
+	},
+}
+
+// ValidateProtocol checks that the protocol of a server is one of the known protocols
+func ValidateProtocol(protocol string) error {
+	if _, ok := Protocols[protocol]; ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(Protocols))
+	for name := range Protocols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("unknown server type %q. Must be one of: %s", protocol, strings.Join(names, ", "))
+}
+
+// ValidateResourceForProtocol checks that a server with the given protocol supports the
+// resource type and mode
+func ValidateResourceForProtocol(protocol string, resourceType ResourceType, mode ResourceMode) error {
+	if err := ValidateProtocol(protocol); err != nil {
+		return err
+	}
+	capabilities := Protocols[protocol]
+
+	if !containsResourceType(capabilities.ResourceTypes, resourceType) {
+		return fmt.Errorf("resource type %q is not supported by %s servers. Must be one of: %s", resourceType, protocol, joinResourceTypes(capabilities.ResourceTypes))
+	}
+	if !containsResourceMode(capabilities.ResourceModes, mode) {
+		return fmt.Errorf("resource mode %q is not supported by %s servers. Must be one of: %s", mode, protocol, joinResourceModes(capabilities.ResourceModes))
+	}
+	return nil
+}
+
+// ValidateBindForProtocol checks that a server with the given protocol supports binds and
+// that the bind goes from an exchange to a queue
+func ValidateBindForProtocol(protocol string, sourceType ResourceType, destinationType ResourceType) error {
+	if !Protocols[protocol].Binds {
+		return fmt.Errorf("binds are not supported by %s servers", protocol)
+	}
+	if sourceType != ResourceTypeExchange {
+		return fmt.Errorf("bind source must be an exchange, got %s", sourceType)
+	}
+	if destinationType != ResourceTypeQueue {
+		return fmt.Errorf("bind destination must be a queue, got %s", destinationType)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ResourceURI references a resource of a server, e.g. async+kafka://mainkafka@readwrite/topic/emails
+// where async+kafka is the protocol of the server, mainkafka the server name, readwrite the
+// resource mode, topic the resource type and emails the resource name.
+type ResourceURI struct {
+	Protocol string
+	Server   string
+	Mode     ResourceMode
+	Type     ResourceType
+	Name     string
+}
+
+// ParseResourceURI parses and validates a resource URI
+func ParseResourceURI(raw string) (*ResourceURI, error) {
+	protocol, rest, found := strings.Cut(raw, "://")
+	if !found {
+		return nil, fmt.Errorf("invalid resource URI %q: expected protocol://server@mode/type/name", raw)
+	}
+
+	authority, path, found := strings.Cut(rest, "/")
+	if !found {
+		return nil, fmt.Errorf("invalid resource URI %q: missing resource type and name", raw)
+	}
+
+	server, mode, found := strings.Cut(authority, "@")
+	if !found {
+		return nil, fmt.Errorf("invalid resource URI %q: missing resource mode after server name", raw)
+	}
+
+	resourceType, name, found := strings.Cut(path, "/")
+	if !found {
+		return nil, fmt.Errorf("invalid resource URI %q: missing resource name", raw)
+	}
+
+	uri := &ResourceURI{
+		Protocol: protocol,
+		Server:   server,
+		Mode:     ResourceMode(mode),
+		Type:     ResourceType(resourceType),
+		Name:     name,
+	}
+	if err := uri.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid resource URI %q: %s", raw, err)
+	}
+
+	return uri, nil
+}
+
+// String formats the URI as protocol://server@mode/type/name
+func (u ResourceURI) String() string {
+	return fmt.Sprintf("%s://%s@%s/%s/%s", u.Protocol, u.Server, u.Mode, u.Type, u.Name)
+}
+
+// Validate checks that all parts of the URI are set and that the resource type and mode are known
+func (u ResourceURI) Validate() error {
+	if u.Protocol == "" {
+		return errors.New("protocol is required")
+	}
+	if u.Server == "" {
+		return errors.New("server name is required")
+	}
+	if u.Name == "" {
+		return errors.New("resource name is required")
+	}
+	if !isKnownResourceType(u.Type) {
+		return fmt.Errorf("unknown resource type %q. Must be one of: %s", u.Type, joinResourceTypes(ResourceTypes))
+	}
+	if !isKnownResourceMode(u.Mode) {
+		return fmt.Errorf("unknown resource mode %q. Must be one of: %s", u.Mode, joinResourceModes(ResourceModes))
+	}
+	return nil
+}
+
+// ValidateForServer checks that the URI points to a server with the given name and protocol,
+// and that the protocol supports the resource type and mode
+func (u ResourceURI) ValidateForServer(serverName string, protocol string) error {
+	if u.Server != serverName {
+		return fmt.Errorf("resource URI %s does not reference server %q", u, serverName)
+	}
+	if u.Protocol != protocol {
+		return fmt.Errorf("resource URI %s uses protocol %q but server %q is %q", u, u.Protocol, serverName, protocol)
+	}
+	return ValidateResourceForProtocol(protocol, u.Type, u.Mode)
+}
+
+func isKnownResourceType(resourceType ResourceType) bool {
+	return containsResourceType(ResourceTypes, resourceType)
+}
+
+func isKnownResourceMode(mode ResourceMode) bool {
+	return containsResourceMode(ResourceModes, mode)
+}
+
+func containsResourceType(resourceTypes []ResourceType, resourceType ResourceType) bool {
+	for _, known := range resourceTypes {
+		if known == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsResourceMode(modes []ResourceMode, mode ResourceMode) bool {
+	for _, known := range modes {
+		if known == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func joinResourceTypes(resourceTypes []ResourceType) string {
+	names := make([]string, len(resourceTypes))
+	for i, resourceType := range resourceTypes {
+		names[i] = string(resourceType)
+	}
+	return strings.Join(names, ", ")
+}
+
+func joinResourceModes(modes []ResourceMode) string {
+	names := make([]string, len(modes))
+	for i, mode := range modes {
+		names[i] = string(mode)
+	}
+	return strings.Join(names, ", ")
+}
@@ -20,6 +20,23 @@ const (
 	ResourceModeReadWrite ResourceMode = "readwrite"
 )
 
+// ResourceTypes lists all resource types known to paw
+var ResourceTypes = []ResourceType{
+	ResourceTypeKafkaTopic,
+	ResourceTypeExchange,
+	ResourceTypeQueue,
+	ResourceTypeTable,
+	ResourceTypeEndpoint,
+}
+
+// ResourceModes lists all resource modes known to paw
+var ResourceModes = []ResourceMode{
+	ResourceModeRead,
+	ResourceModeWrite,
+	ResourceModeBind,
+	ResourceModeReadWrite,
+}
+
 type CreateResourceRequest struct {
 	Name         string       `json:"name"`
 	Description  string       `json:"description"`
@@ -28,6 +45,13 @@ type CreateResourceRequest struct {
 	ServerID     string       `json:"server_id"`
 }
 
+// UpdateResourceRequest changes a resource, empty fields are left unchanged
+type UpdateResourceRequest struct {
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Mode        ResourceMode `json:"mode,omitempty"`
+}
+
 type ResourceResponse struct {
 	ID                string    `json:"id"`
 	Name              string    `json:"name"`
@@ -0,0 +1,16 @@
+package contracts
+
+type SchemaType string
+
+const (
+	SchemaTypeJSONSchema SchemaType = "jsonschema"
+	SchemaTypeAvro       SchemaType = "avro"
+	SchemaTypeProtobuf   SchemaType = "protobuf"
+)
+
+// SchemaTypes lists all schema types supported by paw
+var SchemaTypes = []SchemaType{
+	SchemaTypeJSONSchema,
+	SchemaTypeAvro,
+	SchemaTypeProtobuf,
+}
@@ -1,17 +1,19 @@
 package contracts
 
 type ServerResource struct {
-	Name         string `json:"name"`
-	Mode         string `json:"mode"`
-	Type         string `json:"type"`
-	Description  string `json:"description,omitempty"`
-	ResourceName string `json:"resource_name,omitempty"`
+	Name         string `json:"name" yaml:"name"`
+	Mode         string `json:"mode" yaml:"mode"`
+	Type         string `json:"type" yaml:"type"`
+	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
+	ResourceName string `json:"resource_name,omitempty" yaml:"resource_name,omitempty"`
 }
 
+// ServerBind binds a source exchange to a destination queue of the same server
 type ServerBind struct {
-	Source      string `json:"source"`
-	Destination string `json:"destination"`
-	RoutingKey  string `json:"routing_key,omitempty"`
+	ID          string `json:"id,omitempty" yaml:"-"`
+	Source      string `json:"source" yaml:"source"`
+	Destination string `json:"destination" yaml:"destination"`
+	RoutingKey  string `json:"routing_key,omitempty" yaml:"routing_key,omitempty"`
 }
 
 type Server struct {
@@ -32,6 +34,12 @@ type CreateServerRequest struct {
 	ProjectID   string `json:"project_id"`
 }
 
+// UpdateServerRequest changes a server, empty fields are left unchanged
+type UpdateServerRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
 type ServersListResponse struct {
 	Servers []Server `json:"servers"`
 	Total   int      `json:"total"`
@@ -9,4 +9,59 @@ type SettingYAMLFile struct {
 
 type CodeGeneration struct {
 	Language string `yaml:"language"`
+	// OutputDir is where generated code is written, fusioncat by default
+	OutputDir string `yaml:"outputDir,omitempty"`
+}
+
+// ProvisionYAMLFile is the project definition format used by project imports and exports
+type ProvisionYAMLFile struct {
+	Version  int                `yaml:"version"`
+	Servers  []ProvisionServer  `yaml:"servers,omitempty"`
+	Schemas  []ProvisionSchema  `yaml:"schemas,omitempty"`
+	Messages []ProvisionMessage `yaml:"messages,omitempty"`
+	Apps     []ProvisionApp     `yaml:"apps,omitempty"`
+	// Include lists files or globs, relative to the including file, which are merged into the document
+	Include []string `yaml:"include,omitempty"`
+}
+
+type ProvisionServer struct {
+	Name        string           `yaml:"name"`
+	Type        string           `yaml:"type"`
+	Description string           `yaml:"description,omitempty"`
+	Resources   []ServerResource `yaml:"resources,omitempty"`
+	Binds       []ServerBind     `yaml:"binds,omitempty"`
+}
+
+type ProvisionSchema struct {
+	Name        string     `yaml:"name"`
+	Type        SchemaType `yaml:"type"`
+	Version     int        `yaml:"version,omitempty"`
+	Description string     `yaml:"description,omitempty"`
+	Schema      string     `yaml:"schema"`
+	// SchemaFile is read into Schema when the document is loaded, relative to the file declaring it
+	SchemaFile string `yaml:"schema_file,omitempty"`
+}
+
+type ProvisionMessage struct {
+	Name        string                 `yaml:"name"`
+	Description string                 `yaml:"description,omitempty"`
+	Schema      ProvisionMessageSchema `yaml:"schema"`
+	CloudEvents *CloudEventAttributes  `yaml:"cloudevents,omitempty"`
+}
+
+type ProvisionMessageSchema struct {
+	Name    string `yaml:"name"`
+	Version int    `yaml:"version,omitempty"`
+}
+
+type ProvisionApp struct {
+	Name        string                `yaml:"name"`
+	Description string                `yaml:"description,omitempty"`
+	Sends       []ProvisionAppMessage `yaml:"sends,omitempty"`
+	Receives    []ProvisionAppMessage `yaml:"receives,omitempty"`
+}
+
+type ProvisionAppMessage struct {
+	Message  string `yaml:"message"`
+	Resource string `yaml:"resource"`
 }
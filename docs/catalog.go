@@ -0,0 +1,64 @@
+package docs
+
+import (
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+// Catalog is everything the event catalog is generated from. It is built from a provision
+// document, either loaded from a local file or exported from a live project.
+type Catalog struct {
+	Title    string
+	Servers  []contracts.ProvisionServer
+	Schemas  []Schema
+	Messages []contracts.ProvisionMessage
+	Apps     []contracts.ProvisionApp
+}
+
+// Schema is a schema together with its version history
+type Schema struct {
+	contracts.ProvisionSchema
+	// History is only available for live projects
+	History []SchemaVersion
+}
+
+// SchemaVersion describes who created a version of a schema and when
+type SchemaVersion struct {
+	Version       int
+	CreatedByName string
+	CreatedAt     string
+}
+
+// NewCatalog creates a catalog from a provision document
+func NewCatalog(title string, document *contracts.ProvisionYAMLFile) *Catalog {
+	catalog := &Catalog{
+		Title:    title,
+		Servers:  document.Servers,
+		Messages: document.Messages,
+		Apps:     document.Apps,
+	}
+	for _, schema := range document.Schemas {
+		catalog.Schemas = append(catalog.Schemas, Schema{ProvisionSchema: schema})
+	}
+	return catalog
+}
+
+// usage is an app sending or receiving a message through a resource
+type usage struct {
+	App      string
+	Message  string
+	Resource string
+	Sends    bool
+}
+
+func (c *Catalog) usages() []usage {
+	var usages []usage
+	for _, app := range c.Apps {
+		for _, send := range app.Sends {
+			usages = append(usages, usage{App: app.Name, Message: send.Message, Resource: send.Resource, Sends: true})
+		}
+		for _, receive := range app.Receives {
+			usages = append(usages, usage{App: app.Name, Message: receive.Message, Resource: receive.Resource})
+		}
+	}
+	return usages
+}
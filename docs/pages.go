@@ -0,0 +1,569 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/cloudevents"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
+)
+
+// page is a single page of the catalog. Pages are described with a few simple blocks,
+// so the same content can be rendered as Markdown and as HTML.
+type page struct {
+	// Path is the location of the page without extension, e.g. apps/billing
+	Path   string
+	Title  string
+	Blocks []interface{}
+}
+
+type heading struct {
+	Text string
+	// Anchor is the link target of the heading, a slug of the text is used when it is empty
+	Anchor string
+}
+
+type paragraph struct {
+	Text string
+}
+
+type table struct {
+	Header []string
+	Rows   [][]cell
+}
+
+type cell struct {
+	Text string
+	// Link is the path of the linked page, optionally followed by #anchor
+	Link string
+}
+
+type linkList struct {
+	Items []cell
+}
+
+type code struct {
+	Language string
+	Text     string
+}
+
+// pagePaths assigns every element of a catalog its page path. Slugs are lowercased, so
+// names which only differ in case or punctuation, e.g. UserCreated and usercreated, get
+// a numeric suffix instead of overwriting each other's page.
+type pagePaths struct {
+	apps      map[string]string
+	messages  map[string]string
+	schemas   map[string]string
+	servers   map[string]string
+	resources map[string]map[string]string
+}
+
+func newPagePaths(catalog *Catalog) *pagePaths {
+	paths := &pagePaths{resources: map[string]map[string]string{}}
+
+	var names []string
+	for _, app := range catalog.Apps {
+		names = append(names, app.Name)
+	}
+	paths.apps = uniqueSlugs("apps/", names)
+
+	names = nil
+	for _, message := range catalog.Messages {
+		names = append(names, message.Name)
+	}
+	paths.messages = uniqueSlugs("messages/", names)
+
+	names = nil
+	for _, schema := range catalog.Schemas {
+		names = append(names, schema.Name)
+	}
+	paths.schemas = uniqueSlugs("schemas/", names)
+
+	names = nil
+	for _, server := range catalog.Servers {
+		names = append(names, server.Name)
+
+		var resources []string
+		for _, resource := range server.Resources {
+			resources = append(resources, resource.Name)
+		}
+		paths.resources[server.Name] = uniqueSlugs("resource-", resources)
+	}
+	paths.servers = uniqueSlugs("servers/", names)
+
+	return paths
+}
+
+// uniqueSlugs maps names to prefixed slugs, adding a suffix to slugs which are already taken
+func uniqueSlugs(prefix string, names []string) map[string]string {
+	slugs := map[string]string{}
+	taken := map[string]bool{}
+	for _, name := range names {
+		if _, ok := slugs[name]; ok {
+			continue
+		}
+		base := prefix + slug(name)
+		path := base
+		for suffix := 2; taken[path]; suffix++ {
+			path = fmt.Sprintf("%s-%d", base, suffix)
+		}
+		slugs[name] = path
+		taken[path] = true
+	}
+	return slugs
+}
+
+// lookup returns the assigned path of a name, names which are not part of the catalog
+// (e.g. a message referenced by an app but not defined) fall back to the plain slug
+func lookup(slugs map[string]string, prefix string, name string) string {
+	if path, ok := slugs[name]; ok {
+		return path
+	}
+	return prefix + slug(name)
+}
+
+func (p *pagePaths) app(name string) string     { return lookup(p.apps, "apps/", name) }
+func (p *pagePaths) message(name string) string { return lookup(p.messages, "messages/", name) }
+func (p *pagePaths) schema(name string) string  { return lookup(p.schemas, "schemas/", name) }
+func (p *pagePaths) server(name string) string  { return lookup(p.servers, "servers/", name) }
+
+func (p *pagePaths) resourceAnchor(server string, name string) string {
+	return lookup(p.resources[server], "resource-", name)
+}
+
+// resourceCell links a resource URI to the resource on the page of its server
+func (p *pagePaths) resourceCell(raw string) cell {
+	uri, err := contracts.ParseResourceURI(raw)
+	if err != nil {
+		return cell{Text: raw}
+	}
+	return cell{Text: raw, Link: p.server(uri.Server) + "#" + p.resourceAnchor(uri.Server, uri.Name)}
+}
+
+func buildPages(catalog *Catalog) []page {
+	paths := newPagePaths(catalog)
+	pages := []page{buildIndex(catalog, paths)}
+	for _, app := range catalog.Apps {
+		pages = append(pages, buildAppPage(catalog, paths, app))
+	}
+	for _, message := range catalog.Messages {
+		pages = append(pages, buildMessagePage(catalog, paths, message))
+	}
+	for _, schema := range catalog.Schemas {
+		pages = append(pages, buildSchemaPage(catalog, paths, schema))
+	}
+	for _, server := range catalog.Servers {
+		pages = append(pages, buildServerPage(catalog, paths, server))
+	}
+	return pages
+}
+
+func buildIndex(catalog *Catalog, paths *pagePaths) page {
+	index := page{Path: "index", Title: catalog.Title}
+
+	section := func(title string, items []cell) {
+		index.Blocks = append(index.Blocks, heading{Text: title})
+		if len(items) == 0 {
+			index.Blocks = append(index.Blocks, paragraph{Text: "None."})
+			return
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i].Text < items[j].Text })
+		index.Blocks = append(index.Blocks, linkList{Items: items})
+	}
+
+	var apps, messages, schemaItems, servers []cell
+	for _, app := range catalog.Apps {
+		apps = append(apps, cell{Text: app.Name, Link: paths.app(app.Name)})
+	}
+	for _, message := range catalog.Messages {
+		messages = append(messages, cell{Text: message.Name, Link: paths.message(message.Name)})
+	}
+	for _, schema := range catalog.Schemas {
+		schemaItems = append(schemaItems, cell{Text: schema.Name, Link: paths.schema(schema.Name)})
+	}
+	for _, server := range catalog.Servers {
+		servers = append(servers, cell{Text: server.Name, Link: paths.server(server.Name)})
+	}
+
+	section("Apps", apps)
+	section("Messages", messages)
+	section("Schemas", schemaItems)
+	section("Servers", servers)
+	return index
+}
+
+func buildAppPage(catalog *Catalog, paths *pagePaths, app contracts.ProvisionApp) page {
+	p := page{Path: paths.app(app.Name), Title: "App " + app.Name}
+	if app.Description != "" {
+		p.Blocks = append(p.Blocks, paragraph{Text: app.Description})
+	}
+
+	communication := func(title string, entries []contracts.ProvisionAppMessage) {
+		p.Blocks = append(p.Blocks, heading{Text: title})
+		if len(entries) == 0 {
+			p.Blocks = append(p.Blocks, paragraph{Text: "None."})
+			return
+		}
+		t := table{Header: []string{"Message", "Resource"}}
+		for _, entry := range entries {
+			t.Rows = append(t.Rows, []cell{
+				{Text: entry.Message, Link: paths.message(entry.Message)},
+				paths.resourceCell(entry.Resource),
+			})
+		}
+		p.Blocks = append(p.Blocks, t)
+	}
+
+	communication("Sends", app.Sends)
+	communication("Receives", app.Receives)
+	return p
+}
+
+func buildMessagePage(catalog *Catalog, paths *pagePaths, message contracts.ProvisionMessage) page {
+	p := page{Path: paths.message(message.Name), Title: "Message " + message.Name}
+	if message.Description != "" {
+		p.Blocks = append(p.Blocks, paragraph{Text: message.Description})
+	}
+
+	schemaText := message.Schema.Name
+	if message.Schema.Version != 0 {
+		schemaText = fmt.Sprintf("%s (version %d)", message.Schema.Name, message.Schema.Version)
+	}
+	p.Blocks = append(p.Blocks, heading{Text: "Schema"})
+	p.Blocks = append(p.Blocks, linkList{Items: []cell{{Text: schemaText, Link: paths.schema(message.Schema.Name)}}})
+
+	t := table{Header: []string{"App", "Direction", "Resource"}}
+	for _, u := range catalog.usages() {
+		if u.Message != message.Name {
+			continue
+		}
+		direction := "receives"
+		if u.Sends {
+			direction = "sends"
+		}
+		t.Rows = append(t.Rows, []cell{
+			{Text: u.App, Link: paths.app(u.App)},
+			{Text: direction},
+			paths.resourceCell(u.Resource),
+		})
+	}
+	p.Blocks = append(p.Blocks, heading{Text: "Producers and consumers"})
+	if len(t.Rows) == 0 {
+		p.Blocks = append(p.Blocks, paragraph{Text: "No app sends or receives this message."})
+	} else {
+		p.Blocks = append(p.Blocks, t)
+	}
+
+	if message.CloudEvents != nil {
+		source := message.CloudEvents.Source
+		if source == "" {
+			source = "any"
+		}
+		dataSchema := message.CloudEvents.DataSchema
+		if dataSchema == "" {
+			dataSchema = "any"
+		}
+		p.Blocks = append(p.Blocks,
+			heading{Text: "CloudEvents"},
+			paragraph{Text: "Events of this message travel inside CloudEvents envelopes, the payload is the data of the event."},
+			table{
+				Header: []string{"Attribute", "Value"},
+				Rows: [][]cell{
+					{{Text: "specversion"}, {Text: cloudevents.SpecVersion}},
+					{{Text: "type"}, {Text: message.CloudEvents.Type}},
+					{{Text: "source"}, {Text: source}},
+					{{Text: "dataschema"}, {Text: dataSchema}},
+				},
+			})
+	}
+
+	for _, schema := range catalog.Schemas {
+		if schema.Name != message.Schema.Name {
+			continue
+		}
+		example, ok := schemaExampleValue(schema.ProvisionSchema)
+		if !ok {
+			continue
+		}
+		if message.CloudEvents != nil {
+			example = cloudevents.Wrap(*message.CloudEvents, exampleEventID, example)
+		}
+		if text := formatExample(example); text != "" {
+			p.Blocks = append(p.Blocks, heading{Text: "Example"}, code{Language: "json", Text: text})
+		}
+	}
+	return p
+}
+
+func buildSchemaPage(catalog *Catalog, paths *pagePaths, schema Schema) page {
+	p := page{Path: paths.schema(schema.Name), Title: "Schema " + schema.Name}
+	if schema.Description != "" {
+		p.Blocks = append(p.Blocks, paragraph{Text: schema.Description})
+	}
+	p.Blocks = append(p.Blocks, paragraph{Text: fmt.Sprintf("Type: %s", schema.Type)})
+
+	if schema.Type == contracts.SchemaTypeJSONSchema {
+		if rows := propertyRows(schema.Schema); len(rows) > 0 {
+			p.Blocks = append(p.Blocks, heading{Text: "Properties"}, table{
+				Header: []string{"Property", "Type", "Required", "Description"},
+				Rows:   rows,
+			})
+		}
+		if example := schemaExample(schema.ProvisionSchema); example != "" {
+			p.Blocks = append(p.Blocks, heading{Text: "Example"}, code{Language: "json", Text: example})
+		}
+	}
+
+	var usedBy []cell
+	for _, message := range catalog.Messages {
+		if message.Schema.Name == schema.Name {
+			usedBy = append(usedBy, cell{Text: message.Name, Link: paths.message(message.Name)})
+		}
+	}
+	p.Blocks = append(p.Blocks, heading{Text: "Used by"})
+	if len(usedBy) == 0 {
+		p.Blocks = append(p.Blocks, paragraph{Text: "No message uses this schema."})
+	} else {
+		p.Blocks = append(p.Blocks, linkList{Items: usedBy})
+	}
+
+	if len(schema.History) > 0 {
+		t := table{Header: []string{"Version", "Created by", "Created at"}}
+		for _, version := range schema.History {
+			t.Rows = append(t.Rows, []cell{
+				{Text: fmt.Sprintf("%d", version.Version)},
+				{Text: version.CreatedByName},
+				{Text: version.CreatedAt},
+			})
+		}
+		p.Blocks = append(p.Blocks, heading{Text: "Version history"}, t)
+	}
+
+	language := "json"
+	if schema.Type == contracts.SchemaTypeProtobuf {
+		language = "protobuf"
+	}
+	p.Blocks = append(p.Blocks, heading{Text: "Definition"}, code{Language: language, Text: strings.TrimSpace(schema.Schema)})
+	return p
+}
+
+func buildServerPage(catalog *Catalog, paths *pagePaths, server contracts.ProvisionServer) page {
+	p := page{Path: paths.server(server.Name), Title: "Server " + server.Name}
+	if server.Description != "" {
+		p.Blocks = append(p.Blocks, paragraph{Text: server.Description})
+	}
+	p.Blocks = append(p.Blocks, paragraph{Text: fmt.Sprintf("Protocol: %s", server.Type)})
+
+	usages := catalog.usages()
+	for _, resource := range server.Resources {
+		p.Blocks = append(p.Blocks, heading{Text: fmt.Sprintf("Resource %s", resource.Name), Anchor: paths.resourceAnchor(server.Name, resource.Name)})
+		text := fmt.Sprintf("%s in %s mode.", resource.Type, resource.Mode)
+		if resource.Description != "" {
+			text += " " + resource.Description
+		}
+		p.Blocks = append(p.Blocks, paragraph{Text: text})
+
+		t := table{Header: []string{"App", "Direction", "Message"}}
+		for _, u := range usages {
+			uri, err := contracts.ParseResourceURI(u.Resource)
+			if err != nil || uri.Server != server.Name || uri.Name != resource.Name {
+				continue
+			}
+			direction := "receives"
+			if u.Sends {
+				direction = "sends"
+			}
+			t.Rows = append(t.Rows, []cell{
+				{Text: u.App, Link: paths.app(u.App)},
+				{Text: direction},
+				{Text: u.Message, Link: paths.message(u.Message)},
+			})
+		}
+		if len(t.Rows) > 0 {
+			p.Blocks = append(p.Blocks, t)
+		}
+	}
+
+	if len(server.Binds) > 0 {
+		t := table{Header: []string{"Source", "Destination", "Routing key"}}
+		for _, bind := range server.Binds {
+			t.Rows = append(t.Rows, []cell{
+				{Text: bind.Source, Link: paths.server(server.Name) + "#" + paths.resourceAnchor(server.Name, bind.Source)},
+				{Text: bind.Destination, Link: paths.server(server.Name) + "#" + paths.resourceAnchor(server.Name, bind.Destination)},
+				{Text: bind.RoutingKey},
+			})
+		}
+		p.Blocks = append(p.Blocks, heading{Text: "Bindings"}, t)
+	}
+	return p
+}
+
+// propertyRows lists the properties of a JSON Schema, nested properties use dotted paths
+func propertyRows(content string) [][]cell {
+	var root map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &root); err != nil {
+		return nil
+	}
+
+	var rows [][]cell
+	var walk func(node map[string]interface{}, prefix string, depth int)
+	walk = func(node map[string]interface{}, prefix string, depth int) {
+		node = resolveLocalRef(root, node)
+		if depth > 5 {
+			return
+		}
+		properties, _ := node["properties"].(map[string]interface{})
+		required := map[string]bool{}
+		if list, ok := node["required"].([]interface{}); ok {
+			for _, name := range list {
+				if name, ok := name.(string); ok {
+					required[name] = true
+				}
+			}
+		}
+
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			property, ok := properties[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			property = resolveLocalRef(root, property)
+
+			requiredText := "no"
+			if required[name] {
+				requiredText = "yes"
+			}
+			description, _ := property["description"].(string)
+			if description == "" {
+				description, _ = property["title"].(string)
+			}
+			rows = append(rows, []cell{
+				{Text: prefix + name},
+				{Text: propertyType(property)},
+				{Text: requiredText},
+				{Text: description},
+			})
+
+			walk(property, prefix+name+".", depth+1)
+			if items, ok := property["items"].(map[string]interface{}); ok {
+				walk(items, prefix+name+"[].", depth+1)
+			}
+		}
+	}
+	walk(root, "", 0)
+	return rows
+}
+
+func propertyType(property map[string]interface{}) string {
+	switch value := property["type"].(type) {
+	case string:
+		if format, ok := property["format"].(string); ok {
+			return fmt.Sprintf("%s (%s)", value, format)
+		}
+		return value
+	case []interface{}:
+		types := make([]string, 0, len(value))
+		for _, t := range value {
+			types = append(types, fmt.Sprint(t))
+		}
+		return strings.Join(types, " | ")
+	}
+	if _, ok := property["enum"]; ok {
+		return "enum"
+	}
+	if _, ok := property["oneOf"]; ok {
+		return "oneOf"
+	}
+	if _, ok := property["anyOf"]; ok {
+		return "anyOf"
+	}
+	return "any"
+}
+
+// resolveLocalRef follows a $ref pointing into the same document, e.g. #/$defs/address
+func resolveLocalRef(root map[string]interface{}, node map[string]interface{}) map[string]interface{} {
+	ref, ok := node["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#/") {
+		return node
+	}
+
+	var current interface{} = root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		current = object[token]
+	}
+	if resolved, ok := current.(map[string]interface{}); ok {
+		return resolved
+	}
+	return node
+}
+
+// exampleEventID is the ID of example events of CloudEvents messages
+const exampleEventID = "00000000-0000-0000-0000-000000000001"
+
+// schemaExample returns the first example of a JSON Schema, or a generated sample
+func schemaExample(schema contracts.ProvisionSchema) string {
+	example, ok := schemaExampleValue(schema)
+	if !ok {
+		return ""
+	}
+	return formatExample(example)
+}
+
+func schemaExampleValue(schema contracts.ProvisionSchema) (interface{}, bool) {
+	if schema.Type != contracts.SchemaTypeJSONSchema {
+		return nil, false
+	}
+
+	var root map[string]interface{}
+	if err := json.Unmarshal([]byte(schema.Schema), &root); err != nil {
+		return nil, false
+	}
+
+	if examples, ok := root["examples"].([]interface{}); ok && len(examples) > 0 {
+		return examples[0], true
+	}
+	sampler, err := schemas.NewSampler(schema.Schema, 1)
+	if err != nil {
+		return nil, false
+	}
+	example, err := sampler.Generate()
+	if err != nil {
+		return nil, false
+	}
+	return example, true
+}
+
+func formatExample(example interface{}) string {
+	data, err := json.MarshalIndent(example, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// slug turns a name into a file name which is safe on all platforms
+func slug(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,165 @@
+package docs
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// Formats lists the supported output formats of the catalog
+var Formats = []string{FormatMarkdown, FormatHTML}
+
+// Generate writes the catalog to outDir in the given format and returns the paths of the written files
+func Generate(catalog *Catalog, format string, outDir string) ([]string, error) {
+	var extension string
+	var render func(p page, extension string) string
+	switch format {
+	case FormatMarkdown:
+		extension, render = ".md", renderMarkdown
+	case FormatHTML:
+		extension, render = ".html", renderHTML
+	default:
+		return nil, fmt.Errorf("unsupported docs format %q. Must be one of: %s", format, strings.Join(Formats, ", "))
+	}
+
+	var written []string
+	for _, p := range buildPages(catalog) {
+		filePath := filepath.Join(outDir, filepath.FromSlash(p.Path+extension))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return written, err
+		}
+		if err := os.WriteFile(filePath, []byte(render(p, extension)), 0644); err != nil {
+			return written, err
+		}
+		written = append(written, filePath)
+	}
+	return written, nil
+}
+
+// relativeLink turns a link to a page path into a link relative to the page it appears on
+func relativeLink(from string, link string, extension string) string {
+	target, anchor, _ := strings.Cut(link, "#")
+	relative, err := filepath.Rel(path.Dir(from), target)
+	if err != nil {
+		relative = target
+	}
+	relative = filepath.ToSlash(relative) + extension
+	if anchor != "" {
+		relative += "#" + anchor
+	}
+	return relative
+}
+
+func renderMarkdown(p page, extension string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", p.Title)
+	if p.Path != "index" {
+		fmt.Fprintf(&b, "\n[Back to index](%s)\n", relativeLink(p.Path, "index", extension))
+	}
+
+	link := func(c cell) string {
+		if c.Link == "" {
+			return c.Text
+		}
+		return fmt.Sprintf("[%s](%s)", c.Text, relativeLink(p.Path, c.Link, extension))
+	}
+
+	for _, block := range p.Blocks {
+		b.WriteString("\n")
+		switch block := block.(type) {
+		case heading:
+			if block.Anchor != "" {
+				fmt.Fprintf(&b, "<a id=\"%s\"></a>\n\n", block.Anchor)
+			}
+			fmt.Fprintf(&b, "## %s\n", block.Text)
+		case paragraph:
+			fmt.Fprintf(&b, "%s\n", block.Text)
+		case linkList:
+			for _, item := range block.Items {
+				fmt.Fprintf(&b, "- %s\n", link(item))
+			}
+		case table:
+			fmt.Fprintf(&b, "| %s |\n", strings.Join(block.Header, " | "))
+			fmt.Fprintf(&b, "|%s\n", strings.Repeat(" --- |", len(block.Header)))
+			for _, row := range block.Rows {
+				cells := make([]string, 0, len(row))
+				for _, c := range row {
+					c.Text = strings.NewReplacer("|", "\\|", "\n", " ").Replace(c.Text)
+					cells = append(cells, link(c))
+				}
+				fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+			}
+		case code:
+			fmt.Fprintf(&b, "```%s\n%s\n```\n", block.Language, block.Text)
+		}
+	}
+	return b.String()
+}
+
+// style is inlined into every page, so the site works offline and without a web server
+const style = `body{font-family:-apple-system,"Segoe UI",Helvetica,Arial,sans-serif;max-width:960px;margin:2em auto;padding:0 1em;color:#222;line-height:1.5}
+a{color:#0366d6;text-decoration:none}a:hover{text-decoration:underline}
+table{border-collapse:collapse;width:100%;margin:1em 0}th,td{border:1px solid #ddd;padding:.4em .6em;text-align:left;vertical-align:top}th{background:#f6f8fa}
+pre{background:#f6f8fa;padding:1em;overflow:auto;border-radius:4px}nav{margin-bottom:1em;font-size:.9em}`
+
+func renderHTML(p page, extension string) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&b, "<title>%s</title>\n<style>%s</style>\n</head>\n<body>\n", html.EscapeString(p.Title), style)
+	if p.Path != "index" {
+		fmt.Fprintf(&b, "<nav><a href=\"%s\">Back to index</a></nav>\n", html.EscapeString(relativeLink(p.Path, "index", extension)))
+	}
+	fmt.Fprintf(&b, "<h1>%s</h1>\n", html.EscapeString(p.Title))
+
+	link := func(c cell) string {
+		if c.Link == "" {
+			return html.EscapeString(c.Text)
+		}
+		return fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(relativeLink(p.Path, c.Link, extension)), html.EscapeString(c.Text))
+	}
+
+	for _, block := range p.Blocks {
+		switch block := block.(type) {
+		case heading:
+			anchor := block.Anchor
+			if anchor == "" {
+				anchor = slug(block.Text)
+			}
+			fmt.Fprintf(&b, "<h2 id=\"%s\">%s</h2>\n", anchor, html.EscapeString(block.Text))
+		case paragraph:
+			fmt.Fprintf(&b, "<p>%s</p>\n", html.EscapeString(block.Text))
+		case linkList:
+			b.WriteString("<ul>\n")
+			for _, item := range block.Items {
+				fmt.Fprintf(&b, "<li>%s</li>\n", link(item))
+			}
+			b.WriteString("</ul>\n")
+		case table:
+			b.WriteString("<table>\n<tr>")
+			for _, header := range block.Header {
+				fmt.Fprintf(&b, "<th>%s</th>", html.EscapeString(header))
+			}
+			b.WriteString("</tr>\n")
+			for _, row := range block.Rows {
+				b.WriteString("<tr>")
+				for _, c := range row {
+					fmt.Fprintf(&b, "<td>%s</td>", link(c))
+				}
+				b.WriteString("</tr>\n")
+			}
+			b.WriteString("</table>\n")
+		case code:
+			fmt.Fprintf(&b, "<pre><code class=\"language-%s\">%s</code></pre>\n", block.Language, html.EscapeString(block.Text))
+		}
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
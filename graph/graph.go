@@ -0,0 +1,251 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+// NodeKind is the kind of an element in the topology graph
+type NodeKind string
+
+const (
+	NodeKindApp      NodeKind = "app"
+	NodeKindResource NodeKind = "resource"
+)
+
+// Node is an app or a resource. Resources are grouped by the server they belong to.
+type Node struct {
+	ID     string   `json:"id"`
+	Kind   NodeKind `json:"kind"`
+	Name   string   `json:"name"`
+	Server string   `json:"server,omitempty"`
+	// Type is the resource type, e.g. topic or queue
+	Type string `json:"type,omitempty"`
+}
+
+// Edge is a message flowing from a producer app to a resource, or from a resource to a consumer app
+type Edge struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Message string `json:"message"`
+}
+
+// Server groups the resources of a server
+type Server struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Protocol string `json:"protocol"`
+}
+
+// Graph is the topology of a project
+type Graph struct {
+	Servers []Server `json:"servers"`
+	Nodes   []Node   `json:"nodes"`
+	Edges   []Edge   `json:"edges"`
+}
+
+// Filter limits the graph to the flows of a single app or server. Empty fields do not filter.
+type Filter struct {
+	App    string
+	Server string
+}
+
+// flow is a single app sending or receiving a message through a resource
+type flow struct {
+	App      string
+	Message  string
+	Resource contracts.ResourceURI
+	Sends    bool
+}
+
+// Build creates the topology graph of a provision document
+func Build(document *contracts.ProvisionYAMLFile, filter Filter) (*Graph, error) {
+	var flows []flow
+	for _, app := range document.Apps {
+		for _, send := range app.Sends {
+			uri, err := contracts.ParseResourceURI(send.Resource)
+			if err != nil {
+				return nil, fmt.Errorf("app %s: %s", app.Name, err)
+			}
+			flows = append(flows, flow{App: app.Name, Message: send.Message, Resource: *uri, Sends: true})
+		}
+		for _, receive := range app.Receives {
+			uri, err := contracts.ParseResourceURI(receive.Resource)
+			if err != nil {
+				return nil, fmt.Errorf("app %s: %s", app.Name, err)
+			}
+			flows = append(flows, flow{App: app.Name, Message: receive.Message, Resource: *uri})
+		}
+	}
+
+	flows = filterFlows(flows, filter)
+
+	g := &Graph{}
+	nodes := map[string]Node{}
+	servers := map[string]Server{}
+	edges := map[Edge]bool{}
+	ids := newNodeIDs()
+
+	for _, f := range flows {
+		appNode := Node{ID: ids.get(NodeKindApp, f.App), Kind: NodeKindApp, Name: f.App}
+		resourceNode := Node{
+			ID:     ids.get(NodeKindResource, f.Resource.Server, f.Resource.Name),
+			Kind:   NodeKindResource,
+			Name:   f.Resource.Name,
+			Server: f.Resource.Server,
+			Type:   string(f.Resource.Type),
+		}
+		nodes[appNode.ID] = appNode
+		nodes[resourceNode.ID] = resourceNode
+		if _, ok := servers[f.Resource.Server]; !ok {
+			servers[f.Resource.Server] = Server{ID: ids.get("server", f.Resource.Server), Name: f.Resource.Server, Protocol: f.Resource.Protocol}
+		}
+
+		if f.Sends {
+			edges[Edge{From: appNode.ID, To: resourceNode.ID, Message: f.Message}] = true
+		} else {
+			edges[Edge{From: resourceNode.ID, To: appNode.ID, Message: f.Message}] = true
+		}
+	}
+
+	// Apps, servers and resources without any communication are still part of an unfiltered graph
+	if filter.App == "" && filter.Server == "" {
+		for _, app := range document.Apps {
+			id := ids.get(NodeKindApp, app.Name)
+			nodes[id] = Node{ID: id, Kind: NodeKindApp, Name: app.Name}
+		}
+		for _, server := range document.Servers {
+			servers[server.Name] = Server{ID: ids.get("server", server.Name), Name: server.Name, Protocol: server.Type}
+			for _, resource := range server.Resources {
+				id := ids.get(NodeKindResource, server.Name, resource.Name)
+				nodes[id] = Node{ID: id, Kind: NodeKindResource, Name: resource.Name, Server: server.Name, Type: resource.Type}
+			}
+		}
+	}
+
+	for _, server := range servers {
+		g.Servers = append(g.Servers, server)
+	}
+	for _, node := range nodes {
+		g.Nodes = append(g.Nodes, node)
+	}
+	for edge := range edges {
+		g.Edges = append(g.Edges, edge)
+	}
+
+	sort.Slice(g.Servers, func(i, j int) bool { return g.Servers[i].Name < g.Servers[j].Name })
+	sort.Slice(g.Nodes, func(i, j int) bool { return g.Nodes[i].ID < g.Nodes[j].ID })
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Message < b.Message
+	})
+
+	return g, nil
+}
+
+// filterFlows keeps the flows of the filtered server, and for an app its own flows plus the
+// flows of the apps it talks to through the same resource and message
+func filterFlows(flows []flow, filter Filter) []flow {
+	var filtered []flow
+	for _, f := range flows {
+		if filter.Server == "" || f.Resource.Server == filter.Server {
+			filtered = append(filtered, f)
+		}
+	}
+	if filter.App == "" {
+		return filtered
+	}
+
+	type channel struct {
+		Server   string
+		Resource string
+		Message  string
+	}
+	channels := map[channel]bool{}
+	for _, f := range filtered {
+		if f.App == filter.App {
+			channels[channel{f.Resource.Server, f.Resource.Name, f.Message}] = true
+		}
+	}
+
+	var related []flow
+	for _, f := range filtered {
+		if f.App == filter.App || channels[channel{f.Resource.Server, f.Resource.Name, f.Message}] {
+			related = append(related, f)
+		}
+	}
+	return related
+}
+
+// Resources returns the resource nodes of a server
+func (g *Graph) Resources(server string) []Node {
+	var resources []Node
+	for _, node := range g.Nodes {
+		if node.Kind == NodeKindResource && node.Server == server {
+			resources = append(resources, node)
+		}
+	}
+	return resources
+}
+
+// Apps returns the app nodes of the graph
+func (g *Graph) Apps() []Node {
+	var apps []Node
+	for _, node := range g.Nodes {
+		if node.Kind == NodeKindApp {
+			apps = append(apps, node)
+		}
+	}
+	return apps
+}
+
+// nodeIDs hands out identifiers which are valid in all supported diagram languages. Names
+// which only differ in characters replaced by the sanitizing, e.g. "user-created" and
+// "user_created", get a numeric suffix so they stay separate nodes.
+type nodeIDs struct {
+	byKey map[string]string
+	taken map[string]bool
+}
+
+func newNodeIDs() *nodeIDs {
+	return &nodeIDs{byKey: map[string]string{}, taken: map[string]bool{}}
+}
+
+// get returns the identifier of an element, resources are identified by their server and name
+func (n *nodeIDs) get(kind NodeKind, names ...string) string {
+	key := string(kind) + "\x00" + strings.Join(names, "\x00")
+	if id, ok := n.byKey[key]; ok {
+		return id
+	}
+
+	base := nodeID(kind, strings.Join(names, "_"))
+	id := base
+	for suffix := 2; n.taken[id]; suffix++ {
+		id = fmt.Sprintf("%s_%d", base, suffix)
+	}
+	n.byKey[key] = id
+	n.taken[id] = true
+	return id
+}
+
+// nodeID builds an identifier which is valid in all supported diagram languages
+func nodeID(kind NodeKind, name string) string {
+	id := []rune(string(kind) + "_")
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			id = append(id, r)
+		} else {
+			id = append(id, '_')
+		}
+	}
+	return string(id)
+}
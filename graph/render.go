@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Formats lists the output formats supported by Render
+var Formats = []string{"dot", "mermaid", "plantuml", "json"}
+
+// Render renders the graph in one of the supported formats
+func Render(g *Graph, format string) (string, error) {
+	switch format {
+	case "dot":
+		return renderDOT(g), nil
+	case "mermaid":
+		return renderMermaid(g), nil
+	case "plantuml":
+		return renderPlantUML(g), nil
+	case "json":
+		data, err := json.MarshalIndent(g, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode graph: %s", err)
+		}
+		return string(data) + "\n", nil
+	default:
+		return "", fmt.Errorf("unsupported graph format %q. Must be one of: %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+func renderDOT(g *Graph) string {
+	var b strings.Builder
+	b.WriteString("digraph project {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [fontname=\"Helvetica\"];\n")
+
+	for _, app := range g.Apps() {
+		fmt.Fprintf(&b, "  %s [label=%q, shape=box, style=rounded];\n", app.ID, app.Name)
+	}
+	for _, server := range g.Servers {
+		fmt.Fprintf(&b, "  subgraph cluster_%s {\n", server.ID)
+		fmt.Fprintf(&b, "    label=%q;\n", fmt.Sprintf("%s (%s)", server.Name, server.Protocol))
+		for _, resource := range g.Resources(server.Name) {
+			fmt.Fprintf(&b, "    %s [label=%q, shape=cylinder];\n", resource.ID, fmt.Sprintf("%s %s", resource.Type, resource.Name))
+		}
+		b.WriteString("  }\n")
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%q];\n", edge.From, edge.To, edge.Message)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func renderMermaid(g *Graph) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	for _, app := range g.Apps() {
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", app.ID, mermaidText(app.Name))
+	}
+	for _, server := range g.Servers {
+		fmt.Fprintf(&b, "  subgraph %s[\"%s (%s)\"]\n", server.ID, mermaidText(server.Name), mermaidText(server.Protocol))
+		for _, resource := range g.Resources(server.Name) {
+			fmt.Fprintf(&b, "    %s[(\"%s %s\")]\n", resource.ID, mermaidText(resource.Type), mermaidText(resource.Name))
+		}
+		b.WriteString("  end\n")
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -->|\"%s\"| %s\n", edge.From, mermaidText(edge.Message), edge.To)
+	}
+
+	return b.String()
+}
+
+func renderPlantUML(g *Graph) string {
+	var b strings.Builder
+	b.WriteString("@startuml\n")
+	b.WriteString("left to right direction\n")
+
+	for _, app := range g.Apps() {
+		fmt.Fprintf(&b, "component \"%s\" as %s\n", app.Name, app.ID)
+	}
+	for _, server := range g.Servers {
+		fmt.Fprintf(&b, "node \"%s (%s)\" as %s {\n", server.Name, server.Protocol, server.ID)
+		for _, resource := range g.Resources(server.Name) {
+			fmt.Fprintf(&b, "  queue \"%s %s\" as %s\n", resource.Type, resource.Name, resource.ID)
+		}
+		b.WriteString("}\n")
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "%s --> %s : %s\n", edge.From, edge.To, edge.Message)
+	}
+
+	b.WriteString("@enduml\n")
+	return b.String()
+}
+
+// mermaidText escapes quotes, which cannot appear inside quoted mermaid labels
+func mermaidText(text string) string {
+	return strings.ReplaceAll(text, "\"", "#quot;")
+}
@@ -0,0 +1,88 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigFile is looked up in the working directory when no configuration is given
+const DefaultConfigFile = ".pawlint.yaml"
+
+// Config changes the severities and patterns of rules, e.g.
+//
+//	rules:
+//	  description-required: off
+//	  message-naming:
+//	    severity: error
+//	    pattern: "^[A-Z][A-Za-z0-9]*$"
+type Config struct {
+	Rules map[string]RuleConfig `yaml:"rules"`
+}
+
+// RuleConfig is either a severity or a mapping with severity and pattern
+type RuleConfig struct {
+	Severity Severity `yaml:"severity"`
+	Pattern  string   `yaml:"pattern"`
+}
+
+func (r *RuleConfig) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&r.Severity)
+	}
+	type plain RuleConfig
+	return node.Decode((*plain)(r))
+}
+
+// LoadConfig reads a lint configuration file
+func LoadConfig(filePath string) (*Config, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.New("failed to read lint configuration: " + err.Error())
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, errors.New("invalid lint configuration: " + err.Error())
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (c *Config) validate() error {
+	known := map[string]bool{}
+	for _, rule := range Rules {
+		known[rule.ID] = true
+	}
+
+	for id, rule := range c.Rules {
+		if !known[id] {
+			return fmt.Errorf("unknown lint rule %q. Must be one of: %s", id, strings.Join(ruleIDs(), ", "))
+		}
+		switch rule.Severity {
+		case "", SeverityError, SeverityWarning, SeverityNote, SeverityOff:
+		default:
+			return fmt.Errorf("rule %s: unknown severity %q. Must be one of: error, warning, note, off", id, rule.Severity)
+		}
+	}
+	return nil
+}
+
+// resolve returns the severity and pattern of a rule, configured values override the defaults
+func (c *Config) resolve(rule Rule) (Severity, string) {
+	severity, pattern := rule.Severity, rule.Pattern
+	if configured, ok := c.Rules[rule.ID]; ok {
+		if configured.Severity != "" {
+			severity = configured.Severity
+		}
+		if configured.Pattern != "" {
+			pattern = configured.Pattern
+		}
+	}
+	return severity, pattern
+}
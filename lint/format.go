@@ -0,0 +1,198 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FormatText  = "text"
+	FormatJSON  = "json"
+	FormatSARIF = "sarif"
+)
+
+// Formats lists the supported output formats
+var Formats = []string{FormatText, FormatJSON, FormatSARIF}
+
+// Source is the linted project definition. URI is the artifact location of SARIF results
+// without a location of their own. Contents is embedded into SARIF logs when the definition
+// is not a file, e.g. the exported definition of a live project.
+type Source struct {
+	URI      string
+	Contents string
+}
+
+// Format renders findings of the linted source
+func Format(findings []Finding, format string, source Source) (string, error) {
+	switch format {
+	case FormatText:
+		return formatText(findings), nil
+	case FormatJSON:
+		if findings == nil {
+			findings = []Finding{}
+		}
+		data, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	case FormatSARIF:
+		data, err := json.MarshalIndent(newSARIFLog(findings, source), "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	default:
+		return "", fmt.Errorf("unsupported format %q. Must be one of: %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+func formatText(findings []Finding) string {
+	if len(findings) == 0 {
+		return "No problems found\n"
+	}
+
+	var builder strings.Builder
+	for _, finding := range findings {
+		fmt.Fprintf(&builder, "%-7s  %s: %s [%s]\n", finding.Severity, finding.Path, finding.Message, finding.Rule)
+	}
+	fmt.Fprintf(&builder, "\n%d problems (%d errors, %d warnings, %d notes)\n",
+		len(findings), Count(findings, SeverityError), Count(findings, SeverityWarning), Count(findings, SeverityNote))
+	return builder.String()
+}
+
+// SARIF 2.1.0, limited to what code scanning tools need to annotate a file
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool      sarifTool       `json:"tool"`
+	Artifacts []sarifArtifact `json:"artifacts,omitempty"`
+	Results   []sarifResult   `json:"results"`
+}
+
+type sarifArtifact struct {
+	Location sarifArtifactLocation `json:"location"`
+	Contents sarifArtifactContent  `json:"contents"`
+}
+
+type sarifArtifactContent struct {
+	Text string `json:"text"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+func newSARIFLog(findings []Finding, source Source) sarifLog {
+	rules := make([]sarifRule, 0, len(Rules))
+	for _, rule := range Rules {
+		rules = append(rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(rule.Severity)},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(findings))
+	for _, finding := range findings {
+		location := sarifLocation{
+			LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: finding.Path}},
+		}
+		if finding.Location != nil {
+			location.PhysicalLocation = &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(filepath.Clean(finding.Location.File))},
+				Region:           &sarifRegion{StartLine: finding.Location.Line, StartColumn: finding.Location.Column},
+			}
+		} else if source.URI != "" {
+			location.PhysicalLocation = &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(filepath.Clean(source.URI))},
+			}
+		}
+		results = append(results, sarifResult{
+			RuleID:    finding.Rule,
+			Level:     sarifLevel(finding.Severity),
+			Message:   sarifMessage{Text: finding.Message},
+			Locations: []sarifLocation{location},
+		})
+	}
+
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: "paw", Rules: rules}},
+		Results: results,
+	}
+	if source.Contents != "" {
+		run.Artifacts = []sarifArtifact{{
+			Location: sarifArtifactLocation{URI: filepath.ToSlash(filepath.Clean(source.URI))},
+			Contents: sarifArtifactContent{Text: source.Contents},
+		}}
+	}
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+}
+
+func sarifLevel(severity Severity) string {
+	if severity == SeverityOff {
+		return "none"
+	}
+	return string(severity)
+}
@@ -0,0 +1,332 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/provision"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+	SeverityOff     Severity = "off"
+)
+
+// Finding is a single rule violation. Path locates the offending element in the project
+// definition, e.g. apps[0] (billing), Location is where the element is defined when known.
+type Finding struct {
+	Rule     string              `json:"rule"`
+	Severity Severity            `json:"severity"`
+	Path     string              `json:"path"`
+	Message  string              `json:"message"`
+	Location *provision.Location `json:"location,omitempty"`
+}
+
+// Rule is a built-in lint rule
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+	// Pattern is the default naming convention of naming rules
+	Pattern string
+	check   func(document *contracts.ProvisionYAMLFile, pattern *regexp.Regexp, report reporter)
+}
+
+type reporter func(at target, format string, args ...interface{})
+
+// target is the element of the document a finding is about
+type target struct {
+	path     string
+	location func(locations *provision.Locations) *provision.Location
+}
+
+// Rules lists all built-in rules with their default severities
+var Rules = []Rule{
+	{
+		ID:          "app-naming",
+		Description: "App names follow the naming convention",
+		Severity:    SeverityWarning,
+		Pattern:     `^[a-z][a-z0-9_]*$`,
+		check:       checkAppNaming,
+	},
+	{
+		ID:          "message-naming",
+		Description: "Message names follow the naming convention",
+		Severity:    SeverityWarning,
+		Pattern:     `^[a-z][a-z0-9_]*$`,
+		check:       checkMessageNaming,
+	},
+	{
+		ID:          "resource-naming",
+		Description: "Topic, queue and other resource names follow the naming convention",
+		Severity:    SeverityWarning,
+		Pattern:     `^[a-z][a-z0-9_.-]*$`,
+		check:       checkResourceNaming,
+	},
+	{
+		ID:          "description-required",
+		Description: "Servers, schemas, messages and apps have a description",
+		Severity:    SeverityWarning,
+		check:       checkDescriptions,
+	},
+	{
+		ID:          "message-producer-consumer",
+		Description: "Every message has at least one producer and one consumer",
+		Severity:    SeverityWarning,
+		check:       checkProducersAndConsumers,
+	},
+	{
+		ID:          "unused-schema",
+		Description: "Every schema is used by a message",
+		Severity:    SeverityWarning,
+		check:       checkUnusedSchemas,
+	},
+	{
+		ID:          "schema-examples",
+		Description: "JSON Schemas have examples",
+		Severity:    SeverityNote,
+		check:       checkSchemaExamples,
+	},
+	{
+		ID:          "readwrite-resource",
+		Description: "Resources are not readwrite when apps only read from or only write to them",
+		Severity:    SeverityWarning,
+		check:       checkReadWriteResources,
+	},
+}
+
+// Run checks the document against all rules enabled by the configuration. locations may be
+// nil, findings then have no location.
+func Run(document *contracts.ProvisionYAMLFile, locations *provision.Locations, config *Config) ([]Finding, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, rule := range Rules {
+		severity, pattern := config.resolve(rule)
+		if severity == SeverityOff {
+			continue
+		}
+
+		var compiled *regexp.Regexp
+		if pattern != "" {
+			var err error
+			if compiled, err = regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("rule %s: invalid pattern %q: %s", rule.ID, pattern, err)
+			}
+		}
+
+		rule.check(document, compiled, func(at target, format string, args ...interface{}) {
+			findings = append(findings, Finding{
+				Rule:     rule.ID,
+				Severity: severity,
+				Path:     at.path,
+				Message:  fmt.Sprintf(format, args...),
+				Location: at.location(locations),
+			})
+		})
+	}
+	return findings, nil
+}
+
+// Count returns the number of findings with the given severity
+func Count(findings []Finding, severity Severity) int {
+	count := 0
+	for _, finding := range findings {
+		if finding.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+func checkAppNaming(document *contracts.ProvisionYAMLFile, pattern *regexp.Regexp, report reporter) {
+	for i, app := range document.Apps {
+		if !pattern.MatchString(app.Name) {
+			report(appTarget(i, app), "app name %q does not match %s", app.Name, pattern)
+		}
+	}
+}
+
+func checkMessageNaming(document *contracts.ProvisionYAMLFile, pattern *regexp.Regexp, report reporter) {
+	for i, message := range document.Messages {
+		if !pattern.MatchString(message.Name) {
+			report(messageTarget(i, message), "message name %q does not match %s", message.Name, pattern)
+		}
+	}
+}
+
+func checkResourceNaming(document *contracts.ProvisionYAMLFile, pattern *regexp.Regexp, report reporter) {
+	for i, server := range document.Servers {
+		for j, resource := range server.Resources {
+			if !pattern.MatchString(resource.Name) {
+				report(resourceTarget(i, server, j, resource), "%s name %q does not match %s", resource.Type, resource.Name, pattern)
+			}
+		}
+	}
+}
+
+func checkDescriptions(document *contracts.ProvisionYAMLFile, _ *regexp.Regexp, report reporter) {
+	for i, server := range document.Servers {
+		if server.Description == "" {
+			report(serverTarget(i, server), "server %q has no description", server.Name)
+		}
+	}
+	for i, schema := range document.Schemas {
+		if schema.Description == "" {
+			report(schemaTarget(i, schema), "schema %q has no description", schema.Name)
+		}
+	}
+	for i, message := range document.Messages {
+		if message.Description == "" {
+			report(messageTarget(i, message), "message %q has no description", message.Name)
+		}
+	}
+	for i, app := range document.Apps {
+		if app.Description == "" {
+			report(appTarget(i, app), "app %q has no description", app.Name)
+		}
+	}
+}
+
+func checkProducersAndConsumers(document *contracts.ProvisionYAMLFile, _ *regexp.Regexp, report reporter) {
+	producers := map[string]bool{}
+	consumers := map[string]bool{}
+	for _, app := range document.Apps {
+		for _, send := range app.Sends {
+			producers[send.Message] = true
+		}
+		for _, receive := range app.Receives {
+			consumers[receive.Message] = true
+		}
+	}
+
+	for i, message := range document.Messages {
+		at := messageTarget(i, message)
+		switch {
+		case !producers[message.Name] && !consumers[message.Name]:
+			report(at, "message %q is neither sent nor received by any app", message.Name)
+		case !producers[message.Name]:
+			report(at, "message %q is not sent by any app", message.Name)
+		case !consumers[message.Name]:
+			report(at, "message %q is not received by any app", message.Name)
+		}
+	}
+}
+
+func checkUnusedSchemas(document *contracts.ProvisionYAMLFile, _ *regexp.Regexp, report reporter) {
+	used := map[string]bool{}
+	for _, message := range document.Messages {
+		used[message.Schema.Name] = true
+	}
+	for i, schema := range document.Schemas {
+		if !used[schema.Name] {
+			report(schemaTarget(i, schema), "schema %q is not used by any message", schema.Name)
+		}
+	}
+}
+
+func checkSchemaExamples(document *contracts.ProvisionYAMLFile, _ *regexp.Regexp, report reporter) {
+	for i, schema := range document.Schemas {
+		if schema.Type != contracts.SchemaTypeJSONSchema {
+			continue
+		}
+		var content map[string]interface{}
+		if err := json.Unmarshal([]byte(schema.Schema), &content); err != nil {
+			continue
+		}
+		if examples, ok := content["examples"].([]interface{}); !ok || len(examples) == 0 {
+			report(schemaTarget(i, schema), "schema %q has no examples", schema.Name)
+		}
+	}
+}
+
+func checkReadWriteResources(document *contracts.ProvisionYAMLFile, _ *regexp.Regexp, report reporter) {
+	written := map[string]bool{}
+	read := map[string]bool{}
+	for _, app := range document.Apps {
+		for _, send := range app.Sends {
+			if uri, err := contracts.ParseResourceURI(send.Resource); err == nil {
+				written[uri.Server+"/"+uri.Name] = true
+			}
+		}
+		for _, receive := range app.Receives {
+			if uri, err := contracts.ParseResourceURI(receive.Resource); err == nil {
+				read[uri.Server+"/"+uri.Name] = true
+			}
+		}
+	}
+
+	for i, server := range document.Servers {
+		for j, resource := range server.Resources {
+			if contracts.ResourceMode(resource.Mode) != contracts.ResourceModeReadWrite {
+				continue
+			}
+			key := server.Name + "/" + resource.Name
+			switch {
+			case read[key] && !written[key]:
+				report(resourceTarget(i, server, j, resource), "%s %q is only read by apps, use mode %q", resource.Type, resource.Name, contracts.ResourceModeRead)
+			case written[key] && !read[key]:
+				report(resourceTarget(i, server, j, resource), "%s %q is only written by apps, use mode %q", resource.Type, resource.Name, contracts.ResourceModeWrite)
+			}
+		}
+	}
+}
+
+func serverTarget(index int, server contracts.ProvisionServer) target {
+	return target{
+		path:     fmt.Sprintf("servers[%d] (%s)", index, server.Name),
+		location: func(locations *provision.Locations) *provision.Location { return locations.Server(index) },
+	}
+}
+
+func resourceTarget(serverIndex int, server contracts.ProvisionServer, resourceIndex int, resource contracts.ServerResource) target {
+	return target{
+		path: fmt.Sprintf("servers[%d] (%s): resources[%d] (%s)", serverIndex, server.Name, resourceIndex, resource.Name),
+		location: func(locations *provision.Locations) *provision.Location {
+			return locations.Resource(serverIndex, resourceIndex)
+		},
+	}
+}
+
+func schemaTarget(index int, schema contracts.ProvisionSchema) target {
+	return target{
+		path:     fmt.Sprintf("schemas[%d] (%s)", index, schema.Name),
+		location: func(locations *provision.Locations) *provision.Location { return locations.Schema(index) },
+	}
+}
+
+func messageTarget(index int, message contracts.ProvisionMessage) target {
+	return target{
+		path:     fmt.Sprintf("messages[%d] (%s)", index, message.Name),
+		location: func(locations *provision.Locations) *provision.Location { return locations.Message(index) },
+	}
+}
+
+func appTarget(index int, app contracts.ProvisionApp) target {
+	return target{
+		path:     fmt.Sprintf("apps[%d] (%s)", index, app.Name),
+		location: func(locations *provision.Locations) *provision.Location { return locations.App(index) },
+	}
+}
+
+// ruleIDs returns the IDs of all built-in rules
+func ruleIDs() []string {
+	ids := make([]string, 0, len(Rules))
+	for _, rule := range Rules {
+		ids = append(ids, rule.ID)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,175 @@
+package provision
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+// ProjectServer is a server of a project together with its resources
+type ProjectServer struct {
+	contracts.Server
+	Resources []contracts.ResourceResponse
+}
+
+// ListProjectServers lists the servers of a project with their resources
+func ListProjectServers(client *api.FCApiClient, projectID string) ([]ProjectServer, error) {
+	servers, err := client.ListServers(projectID)
+	if err != nil {
+		return nil, errors.New("failed to list servers: " + err.Error())
+	}
+
+	projectServers := make([]ProjectServer, 0, len(servers.Servers))
+	for _, server := range servers.Servers {
+		resources, err := client.ListServerResources(server.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources of server %s: %s", server.Name, err)
+		}
+		projectServers = append(projectServers, ProjectServer{Server: server, Resources: resources})
+	}
+	return projectServers, nil
+}
+
+// ResourceURIs maps the IDs of the resources of the servers to their resource URIs
+func ResourceURIs(servers []ProjectServer) map[string]string {
+	uris := map[string]string{}
+	for _, server := range servers {
+		for _, resource := range server.Resources {
+			uris[resource.ID] = contracts.ResourceURI{
+				Protocol: server.Protocol,
+				Server:   server.Name,
+				Mode:     contracts.ResourceMode(resource.Mode),
+				Type:     contracts.ResourceType(resource.ResourceType),
+				Name:     resource.Name,
+			}.String()
+		}
+	}
+	return uris
+}
+
+// Export builds a provision document describing the current state of a project
+func Export(client *api.FCApiClient, projectID string) (*contracts.ProvisionYAMLFile, error) {
+	document := &contracts.ProvisionYAMLFile{
+		Version: SupportedVersion,
+	}
+
+	servers, err := ListProjectServers(client, projectID)
+	if err != nil {
+		return nil, err
+	}
+	resourceURIs := ResourceURIs(servers)
+	for _, server := range servers {
+		provisionServer := contracts.ProvisionServer{
+			Name:        server.Name,
+			Type:        server.Protocol,
+			Description: server.Description,
+		}
+		for _, resource := range server.Resources {
+			provisionServer.Resources = append(provisionServer.Resources, contracts.ServerResource{
+				Name:         resource.Name,
+				Mode:         resource.Mode,
+				Type:         resource.ResourceType,
+				Description:  resource.Description,
+				ResourceName: resource.Name,
+			})
+		}
+		if contracts.Protocols[server.Protocol].Binds {
+			binds, err := client.ListServerBinds(server.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list binds of server %s: %s", server.Name, err)
+			}
+			provisionServer.Binds = binds
+		}
+
+		document.Servers = append(document.Servers, provisionServer)
+	}
+
+	schemaList, err := client.ListSchemas(projectID)
+	if err != nil {
+		return nil, errors.New("failed to list schemas: " + err.Error())
+	}
+	schemaNames := map[string]string{}
+	for _, schema := range schemaList {
+		version, err := client.GetLatestSchemaVersion(schema.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest version of schema %s: %s", schema.Name, err)
+		}
+
+		schemaNames[schema.ID] = schema.Name
+		document.Schemas = append(document.Schemas, contracts.ProvisionSchema{
+			Name:        schema.Name,
+			Type:        contracts.SchemaType(schema.Type),
+			Version:     version.Version,
+			Description: schema.Description,
+			Schema:      formatSchemaContent(version.Schema),
+		})
+	}
+
+	messages, err := client.ListMessages(projectID)
+	if err != nil {
+		return nil, errors.New("failed to list messages: " + err.Error())
+	}
+	messageNames := map[string]string{}
+	for _, message := range messages {
+		messageNames[message.ID] = message.Name
+		document.Messages = append(document.Messages, contracts.ProvisionMessage{
+			Name:        message.Name,
+			Description: message.Description,
+			Schema: contracts.ProvisionMessageSchema{
+				Name:    schemaNames[message.SchemaID],
+				Version: message.SchemaVersion,
+			},
+			CloudEvents: message.CloudEvents,
+		})
+	}
+
+	apps, err := client.ListApps(projectID)
+	if err != nil {
+		return nil, errors.New("failed to list apps: " + err.Error())
+	}
+	for _, app := range apps {
+		provisionApp := contracts.ProvisionApp{
+			Name:        app.Name,
+			Description: app.Description,
+		}
+
+		sends, err := client.ListAppSends(app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list messages sent by app %s: %s", app.Name, err)
+		}
+		for _, send := range sends {
+			provisionApp.Sends = append(provisionApp.Sends, contracts.ProvisionAppMessage{
+				Message:  messageNames[send.MessageID],
+				Resource: resourceURIs[send.ResourceID],
+			})
+		}
+
+		receives, err := client.ListAppReceives(app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list messages received by app %s: %s", app.Name, err)
+		}
+		for _, receive := range receives {
+			provisionApp.Receives = append(provisionApp.Receives, contracts.ProvisionAppMessage{
+				Message:  messageNames[receive.MessageID],
+				Resource: resourceURIs[receive.ResourceID],
+			})
+		}
+
+		document.Apps = append(document.Apps, provisionApp)
+	}
+
+	return document, nil
+}
+
+// formatSchemaContent indents JSON based schemas so they are readable in the provision file
+func formatSchemaContent(schema string) string {
+	var formatted bytes.Buffer
+	if err := json.Indent(&formatted, []byte(schema), "", "  "); err != nil {
+		return schema
+	}
+	return formatted.String() + "\n"
+}
@@ -0,0 +1,465 @@
+package provision
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
+	"gopkg.in/yaml.v3"
+)
+
+// variablePattern matches ${NAME} and ${NAME:-default}, $${NAME} escapes a reference
+var variablePattern = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// LoadOptions control how provision files are loaded
+type LoadOptions struct {
+	// Vars are substituted for ${NAME} references, they take precedence over environment variables
+	Vars map[string]string
+}
+
+// Load reads and parses a provision file, see LoadWithOptions
+func Load(filePath string) (*contracts.ProvisionYAMLFile, error) {
+	return LoadWithOptions(filePath, LoadOptions{})
+}
+
+// LoadWithOptions reads a provision file and everything it includes into a single document.
+// Variables are substituted in the values of every file, included files and globs are
+// merged in order, and schema_file references are read (and bundled for JSON Schemas).
+func LoadWithOptions(filePath string, options LoadOptions) (*contracts.ProvisionYAMLFile, error) {
+	document, _, err := LoadWithLocations(filePath, options)
+	return document, err
+}
+
+// LoadWithSources loads a provision file like LoadWithOptions and also returns the absolute
+// paths of all provision and schema files it read, and of the directories glob includes read.
+// The sources read before a failure are returned together with the error, so callers can
+// watch them for a fix.
+func LoadWithSources(filePath string, options LoadOptions) (*contracts.ProvisionYAMLFile, []string, error) {
+	l := &loader{options: options, loading: map[string]bool{}}
+	document, _, err := l.load(filePath)
+	return document, l.sources, err
+}
+
+// LoadWithLocations loads a provision file like LoadWithOptions and also returns where the
+// entries of the document are defined, in the including file or in one of the included files
+func LoadWithLocations(filePath string, options LoadOptions) (*contracts.ProvisionYAMLFile, *Locations, error) {
+	l := &loader{options: options, loading: map[string]bool{}}
+	return l.load(filePath)
+}
+
+// Location is a position in a provision file, lines and columns start at 1
+type Location struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// Locations holds the position of every entry of a document, by the indices of the document
+type Locations struct {
+	Servers   []Location
+	Resources [][]Location
+	Schemas   []Location
+	Messages  []Location
+	Apps      []Location
+}
+
+// Server returns the location of a server, nil when it is not known
+func (l *Locations) Server(index int) *Location {
+	if l == nil {
+		return nil
+	}
+	return locationAt(l.Servers, index)
+}
+
+// Resource returns the location of a resource of a server, nil when it is not known
+func (l *Locations) Resource(serverIndex int, index int) *Location {
+	if l == nil || serverIndex < 0 || serverIndex >= len(l.Resources) {
+		return nil
+	}
+	return locationAt(l.Resources[serverIndex], index)
+}
+
+// Schema returns the location of a schema, nil when it is not known
+func (l *Locations) Schema(index int) *Location {
+	if l == nil {
+		return nil
+	}
+	return locationAt(l.Schemas, index)
+}
+
+// Message returns the location of a message, nil when it is not known
+func (l *Locations) Message(index int) *Location {
+	if l == nil {
+		return nil
+	}
+	return locationAt(l.Messages, index)
+}
+
+// App returns the location of an app, nil when it is not known
+func (l *Locations) App(index int) *Location {
+	if l == nil {
+		return nil
+	}
+	return locationAt(l.Apps, index)
+}
+
+func locationAt(locations []Location, index int) *Location {
+	if index < 0 || index >= len(locations) {
+		return nil
+	}
+	return &locations[index]
+}
+
+// ParseLocations returns where the entries of the content of a provision file are defined.
+// Includes are not followed, file is used as the file of every location.
+func ParseLocations(data []byte, file string) (*Locations, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, errors.New("invalid provision file format: " + err.Error())
+	}
+	return locate(&root, file), nil
+}
+
+// locate collects the positions of the entries of a parsed provision file
+func locate(root *yaml.Node, file string) *Locations {
+	locations := &Locations{}
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return locations
+	}
+
+	at := func(node *yaml.Node) Location {
+		return Location{File: file, Line: node.Line, Column: node.Column}
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		section := root.Content[i+1]
+		if section.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, entry := range section.Content {
+			switch root.Content[i].Value {
+			case "servers":
+				locations.Servers = append(locations.Servers, at(entry))
+				var resources []Location
+				for j := 0; entry.Kind == yaml.MappingNode && j+1 < len(entry.Content); j += 2 {
+					if entry.Content[j].Value == "resources" && entry.Content[j+1].Kind == yaml.SequenceNode {
+						for _, resource := range entry.Content[j+1].Content {
+							resources = append(resources, at(resource))
+						}
+					}
+				}
+				locations.Resources = append(locations.Resources, resources)
+			case "schemas":
+				locations.Schemas = append(locations.Schemas, at(entry))
+			case "messages":
+				locations.Messages = append(locations.Messages, at(entry))
+			case "apps":
+				locations.Apps = append(locations.Apps, at(entry))
+			}
+		}
+	}
+	return locations
+}
+
+type loader struct {
+	options LoadOptions
+	// loading holds the files currently being loaded, to detect include cycles
+	loading map[string]bool
+	// sources holds every file read so far
+	sources []string
+}
+
+func (l *loader) load(filePath string) (*contracts.ProvisionYAMLFile, *Locations, error) {
+	absolutePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, nil, errors.New("failed to resolve file path: " + err.Error())
+	}
+	if l.loading[absolutePath] {
+		return nil, nil, fmt.Errorf("include cycle detected at %s", filePath)
+	}
+	l.loading[absolutePath] = true
+	defer delete(l.loading, absolutePath)
+	l.addSource(absolutePath)
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, nil, errors.New("failed to read file: " + err.Error())
+	}
+
+	document, locations, err := parseExpanded(data, l.options.Vars, filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %s", filePath, err)
+	}
+
+	directory := filepath.Dir(filePath)
+	for i := range document.Schemas {
+		if err := l.loadSchemaFile(&document.Schemas[i], directory); err != nil {
+			return nil, nil, fmt.Errorf("%s: schemas[%d] (%s): %s", filePath, i, document.Schemas[i].Name, err)
+		}
+	}
+
+	includes := document.Include
+	document.Include = nil
+	for _, pattern := range includes {
+		l.addIncludeSources(filepath.Join(directory, pattern))
+		matches, err := filepath.Glob(filepath.Join(directory, pattern))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: invalid include %q: %s", filePath, pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, nil, fmt.Errorf("%s: include %q does not match any file", filePath, pattern)
+		}
+		sort.Strings(matches)
+
+		for _, match := range matches {
+			included, includedLocations, err := l.load(match)
+			if err != nil {
+				return nil, nil, err
+			}
+			// Included files may leave out the version, but must not contradict it
+			if included.Version != 0 && included.Version != document.Version {
+				return nil, nil, fmt.Errorf("%s: version %d does not match version %d of %s", match, included.Version, document.Version, filePath)
+			}
+
+			document.Servers = append(document.Servers, included.Servers...)
+			document.Schemas = append(document.Schemas, included.Schemas...)
+			document.Messages = append(document.Messages, included.Messages...)
+			document.Apps = append(document.Apps, included.Apps...)
+			locations.Servers = append(locations.Servers, includedLocations.Servers...)
+			locations.Resources = append(locations.Resources, includedLocations.Resources...)
+			locations.Schemas = append(locations.Schemas, includedLocations.Schemas...)
+			locations.Messages = append(locations.Messages, includedLocations.Messages...)
+			locations.Apps = append(locations.Apps, includedLocations.Apps...)
+		}
+	}
+
+	return document, locations, nil
+}
+
+// loadSchemaFile reads the schema_file of a schema into its content
+func (l *loader) loadSchemaFile(schema *contracts.ProvisionSchema, directory string) error {
+	if schema.SchemaFile == "" {
+		return nil
+	}
+	if schema.Schema != "" {
+		return errors.New("only one of schema and schema_file can be set")
+	}
+
+	schemaPath := filepath.Join(directory, schema.SchemaFile)
+	if schema.Type == contracts.SchemaTypeJSONSchema {
+		content, sources, err := schemas.BundleFileWithSources(schemaPath)
+		for _, source := range sources {
+			l.addSource(source)
+		}
+		if err != nil {
+			return err
+		}
+		schema.Schema = content
+	} else {
+		if absolutePath, err := filepath.Abs(schemaPath); err == nil {
+			l.addSource(absolutePath)
+		}
+		content, err := os.ReadFile(schemaPath)
+		if err != nil {
+			return errors.New("failed to read schema file: " + err.Error())
+		}
+		schema.Schema = string(content)
+	}
+
+	schema.SchemaFile = ""
+	return nil
+}
+
+// addIncludeSources records what an include depends on besides the files it matches: a plain
+// include depends on its file even before it exists, and a glob on the directories it reads,
+// so files added to them later are picked up by callers watching the sources
+func (l *loader) addIncludeSources(pattern string) {
+	if !hasGlobMeta(pattern) {
+		if absolutePath, err := filepath.Abs(pattern); err == nil {
+			l.addSource(absolutePath)
+		}
+		return
+	}
+
+	// The deepest directory without wildcards sees new subdirectories, the matching
+	// directories below it see new files
+	static := filepath.Dir(pattern)
+	for hasGlobMeta(static) {
+		static = filepath.Dir(static)
+	}
+	directories := []string{static}
+	if dynamic := filepath.Dir(pattern); dynamic != static {
+		matches, _ := filepath.Glob(dynamic)
+		directories = append(directories, matches...)
+	}
+	for _, directory := range directories {
+		if absolutePath, err := filepath.Abs(directory); err == nil {
+			l.addSource(absolutePath)
+		}
+	}
+}
+
+func hasGlobMeta(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+func (l *loader) addSource(filePath string) {
+	for _, source := range l.sources {
+		if source == filePath {
+			return
+		}
+	}
+	l.sources = append(l.sources, filePath)
+}
+
+// ExpandVariables replaces ${NAME} references with the value of the variable from vars or from
+// the environment, ${NAME:-default} falls back to the default and $${NAME} is kept as ${NAME}
+func ExpandVariables(content string, vars map[string]string) (string, error) {
+	undefined := map[string]bool{}
+	expanded := expandVariables(content, vars, undefined)
+	if err := undefinedVariablesError(undefined); err != nil {
+		return "", err
+	}
+	return expanded, nil
+}
+
+// parseExpanded parses the content of a provision file, substitutes variables in its values and
+// records where its entries are defined. Keys and comments are left as they are, and so is the
+// content of inline schemas. Values are replaced after parsing, so they cannot change the
+// structure of the document.
+func parseExpanded(data []byte, vars map[string]string, file string) (*contracts.ProvisionYAMLFile, *Locations, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, nil, errors.New("invalid provision file format: " + err.Error())
+	}
+
+	if err := unknownKeysError(data); err != nil {
+		return nil, nil, err
+	}
+
+	undefined := map[string]bool{}
+	expandNode(&root, vars, undefined)
+	if err := undefinedVariablesError(undefined); err != nil {
+		return nil, nil, err
+	}
+
+	var document contracts.ProvisionYAMLFile
+	if err := root.Decode(&document); err != nil {
+		return nil, nil, errors.New("invalid provision file format: " + err.Error())
+	}
+	return &document, locate(&root, file), nil
+}
+
+// unknownKeysError reports keys which are not part of the provision format, e.g. misspelt ones
+// which would otherwise be dropped silently. Node.Decode cannot reject them, so the original
+// content is decoded strictly, which also keeps the reported lines those of the file.
+func unknownKeysError(data []byte) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var document contracts.ProvisionYAMLFile
+	typeError, ok := decoder.Decode(&document).(*yaml.TypeError)
+	if !ok {
+		return nil
+	}
+
+	// Other type errors may be caused by ${...} references which are not substituted yet
+	var unknown []string
+	for _, message := range typeError.Errors {
+		if strings.Contains(message, " not found in type ") {
+			unknown = append(unknown, message)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	return errors.New("invalid provision file format: " + strings.Join(unknown, "; "))
+}
+
+func expandNode(node *yaml.Node, vars map[string]string, undefined map[string]bool) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			expandNode(child, vars, undefined)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			// Inline schemas are passed on verbatim, ${...} may be part of a pattern or an example
+			if key.Value == "schema" && value.Kind == yaml.ScalarNode {
+				continue
+			}
+			expandNode(value, vars, undefined)
+		}
+	case yaml.ScalarNode:
+		expanded := expandVariables(node.Value, vars, undefined)
+		if expanded != node.Value && node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			// Unquoted values are typed by their substituted content, e.g. version: ${VERSION}
+			node.Tag = ""
+		}
+		node.Value = expanded
+	}
+}
+
+func expandVariables(content string, vars map[string]string, undefined map[string]bool) string {
+	return variablePattern.ReplaceAllStringFunc(content, func(reference string) string {
+		if strings.HasPrefix(reference, "$$") {
+			return reference[1:]
+		}
+
+		match := variablePattern.FindStringSubmatch(reference)
+		name, hasDefault, defaultValue := match[1], match[2] != "", match[3]
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		if value, ok := os.LookupEnv(name); ok {
+			return value
+		}
+		if hasDefault {
+			return defaultValue
+		}
+		undefined[name] = true
+		return reference
+	})
+}
+
+func undefinedVariablesError(undefined map[string]bool) error {
+	if len(undefined) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(undefined))
+	for name := range undefined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("undefined variables: %s. Set them in the environment or with --var", strings.Join(names, ", "))
+}
+
+// Parse parses the content of a provision file
+func Parse(data []byte) (*contracts.ProvisionYAMLFile, error) {
+	var document contracts.ProvisionYAMLFile
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, errors.New("invalid provision file format: " + err.Error())
+	}
+
+	return &document, nil
+}
+
+// Marshal converts a provision document back into YAML
+func Marshal(document *contracts.ProvisionYAMLFile) ([]byte, error) {
+	data, err := yaml.Marshal(document)
+	if err != nil {
+		return nil, errors.New("failed to marshal provision file: " + err.Error())
+	}
+
+	return data, nil
+}
@@ -0,0 +1,202 @@
+package provision
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+type ChangeKind string
+
+const (
+	ChangeAdd    ChangeKind = "add"
+	ChangeUpdate ChangeKind = "update"
+)
+
+// Change is an element of a project which is added or updated when a document is applied
+type Change struct {
+	Kind ChangeKind
+	// Element is one of server, resource, schema, message and app
+	Element string
+	Name    string
+	// Details lists what is updated
+	Details []string
+}
+
+func (c Change) String() string {
+	symbol := "+"
+	if c.Kind == ChangeUpdate {
+		symbol = "~"
+	}
+	line := fmt.Sprintf("%s %s %s", symbol, c.Element, c.Name)
+	if len(c.Details) > 0 {
+		line += ": " + strings.Join(c.Details, ", ")
+	}
+	return line
+}
+
+// Plan compares the current state of a project with a document and returns the changes applying
+// the document would make. Elements which only exist in the project are left alone by imports
+// and are not part of the plan.
+func Plan(current *contracts.ProvisionYAMLFile, desired *contracts.ProvisionYAMLFile) []Change {
+	var changes []Change
+	add := func(kind ChangeKind, element string, name string, details []string) {
+		changes = append(changes, Change{Kind: kind, Element: element, Name: name, Details: details})
+	}
+
+	currentServers := map[string]contracts.ProvisionServer{}
+	for _, server := range current.Servers {
+		currentServers[server.Name] = server
+	}
+	for _, server := range desired.Servers {
+		existing, ok := currentServers[server.Name]
+		if !ok {
+			add(ChangeAdd, "server", server.Name, nil)
+			for _, resource := range server.Resources {
+				add(ChangeAdd, "resource", server.Name+"/"+resource.Name, nil)
+			}
+			continue
+		}
+
+		var details []string
+		details = appendDifference(details, "type", existing.Type, server.Type)
+		details = appendDifference(details, "description", existing.Description, server.Description)
+		if !reflect.DeepEqual(bindKeys(existing.Binds), bindKeys(server.Binds)) {
+			details = append(details, "binds")
+		}
+		if len(details) > 0 {
+			add(ChangeUpdate, "server", server.Name, details)
+		}
+
+		currentResources := map[string]contracts.ServerResource{}
+		for _, resource := range existing.Resources {
+			currentResources[resource.Name] = resource
+		}
+		for _, resource := range server.Resources {
+			existingResource, ok := currentResources[resource.Name]
+			if !ok {
+				add(ChangeAdd, "resource", server.Name+"/"+resource.Name, nil)
+				continue
+			}
+			var details []string
+			details = appendDifference(details, "mode", existingResource.Mode, resource.Mode)
+			details = appendDifference(details, "type", existingResource.Type, resource.Type)
+			details = appendDifference(details, "description", existingResource.Description, resource.Description)
+			details = appendDifference(details, "resource_name", existingResource.ResourceName, resource.ResourceName)
+			if len(details) > 0 {
+				add(ChangeUpdate, "resource", server.Name+"/"+resource.Name, details)
+			}
+		}
+	}
+
+	currentSchemas := map[string]contracts.ProvisionSchema{}
+	for _, schema := range current.Schemas {
+		currentSchemas[schema.Name] = schema
+	}
+	for _, schema := range desired.Schemas {
+		existing, ok := currentSchemas[schema.Name]
+		if !ok {
+			add(ChangeAdd, "schema", schema.Name, nil)
+			continue
+		}
+		var details []string
+		details = appendDifference(details, "type", string(existing.Type), string(schema.Type))
+		details = appendDifference(details, "description", existing.Description, schema.Description)
+		if strings.TrimSpace(existing.Schema) != strings.TrimSpace(schema.Schema) {
+			details = append(details, "new version")
+		}
+		if len(details) > 0 {
+			add(ChangeUpdate, "schema", schema.Name, details)
+		}
+	}
+
+	currentMessages := map[string]contracts.ProvisionMessage{}
+	for _, message := range current.Messages {
+		currentMessages[message.Name] = message
+	}
+	for _, message := range desired.Messages {
+		existing, ok := currentMessages[message.Name]
+		if !ok {
+			add(ChangeAdd, "message", message.Name, nil)
+			continue
+		}
+		var details []string
+		details = appendDifference(details, "description", existing.Description, message.Description)
+		if existing.Schema != message.Schema {
+			details = append(details, fmt.Sprintf("schema %s v%d -> %s v%d",
+				existing.Schema.Name, existing.Schema.Version, message.Schema.Name, message.Schema.Version))
+		}
+		if !reflect.DeepEqual(existing.CloudEvents, message.CloudEvents) {
+			details = append(details, "cloudevents")
+		}
+		if len(details) > 0 {
+			add(ChangeUpdate, "message", message.Name, details)
+		}
+	}
+
+	currentApps := map[string]contracts.ProvisionApp{}
+	for _, app := range current.Apps {
+		currentApps[app.Name] = app
+	}
+	for _, app := range desired.Apps {
+		existing, ok := currentApps[app.Name]
+		if !ok {
+			add(ChangeAdd, "app", app.Name, nil)
+			continue
+		}
+		var details []string
+		details = appendDifference(details, "description", existing.Description, app.Description)
+		details = append(details, usageDifferences("sends", existing.Sends, app.Sends)...)
+		details = append(details, usageDifferences("receives", existing.Receives, app.Receives)...)
+		if len(details) > 0 {
+			add(ChangeUpdate, "app", app.Name, details)
+		}
+	}
+
+	return changes
+}
+
+func appendDifference(details []string, field string, current string, desired string) []string {
+	if current == desired {
+		return details
+	}
+	if current == "" || desired == "" || strings.Contains(current+desired, "\n") {
+		return append(details, field)
+	}
+	return append(details, fmt.Sprintf("%s %s -> %s", field, current, desired))
+}
+
+// usageDifferences describes the messages an app starts and stops sending or receiving
+func usageDifferences(direction string, current []contracts.ProvisionAppMessage, desired []contracts.ProvisionAppMessage) []string {
+	existing := map[contracts.ProvisionAppMessage]bool{}
+	for _, usage := range current {
+		existing[usage] = true
+	}
+	wanted := map[contracts.ProvisionAppMessage]bool{}
+	for _, usage := range desired {
+		wanted[usage] = true
+	}
+
+	var details []string
+	for _, usage := range desired {
+		if !existing[usage] {
+			details = append(details, fmt.Sprintf("%s %s via %s", direction, usage.Message, usage.Resource))
+		}
+	}
+	for _, usage := range current {
+		if !wanted[usage] {
+			details = append(details, fmt.Sprintf("no longer %s %s via %s", direction, usage.Message, usage.Resource))
+		}
+	}
+	return details
+}
+
+func bindKeys(binds []contracts.ServerBind) map[string]bool {
+	keys := map[string]bool{}
+	for _, bind := range binds {
+		keys[bind.Source+" -> "+bind.Destination+" "+bind.RoutingKey] = true
+	}
+	return keys
+}
@@ -0,0 +1,215 @@
+package provision
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
+	"gopkg.in/yaml.v3"
+)
+
+// Mapping renames servers and resources when a project definition is promoted from one
+// environment to another, e.g.
+//
+//	servers:
+//	  devkafka: prodkafka
+//	resources:
+//	  devkafka/emails: emails
+//	prefixes:
+//	  - from: "dev."
+//	    to: "prod."
+type Mapping struct {
+	// Servers maps server names of the source project to server names of the target project
+	Servers map[string]string `yaml:"servers,omitempty"`
+	// Resources maps server/resource names of the source project to resource names of the target
+	// project, the mapped name is also used as the name on the broker
+	Resources map[string]string `yaml:"resources,omitempty"`
+	// Prefixes are replaced in all resource names which are not mapped explicitly, the first
+	// matching prefix wins and an empty from prepends to every name
+	Prefixes []PrefixMapping `yaml:"prefixes,omitempty"`
+}
+
+type PrefixMapping struct {
+	From string `yaml:"from"`
+	To   string `yaml:"to"`
+}
+
+// HeldBackSchema is a schema which was not promoted because it breaks the schema of the target project
+type HeldBackSchema struct {
+	Name              string
+	Incompatibilities []schemas.Incompatibility
+}
+
+// LoadMapping reads a mapping file
+func LoadMapping(filePath string) (*Mapping, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.New("failed to read mapping file: " + err.Error())
+	}
+
+	var mapping Mapping
+	if err := yaml.Unmarshal(data, &mapping); err != nil {
+		return nil, errors.New("invalid mapping file format: " + err.Error())
+	}
+	return &mapping, nil
+}
+
+// Apply renames the servers and resources of the document in place, including the resource
+// URIs of apps and the binds of servers. Mapped servers and resources must exist in the document.
+func (m *Mapping) Apply(document *contracts.ProvisionYAMLFile) error {
+	servers := map[string]bool{}
+	resources := map[string]bool{}
+	for _, server := range document.Servers {
+		servers[server.Name] = true
+		for _, resource := range server.Resources {
+			resources[server.Name+"/"+resource.Name] = true
+		}
+	}
+	for _, name := range sortedMapKeys(m.Servers) {
+		if !servers[name] {
+			return fmt.Errorf("mapped server %q does not exist", name)
+		}
+	}
+	for _, key := range sortedMapKeys(m.Resources) {
+		if !resources[key] {
+			return fmt.Errorf("mapped resource %q does not exist. Resources are mapped as server/resource", key)
+		}
+	}
+
+	for i := range document.Servers {
+		server := &document.Servers[i]
+		for j := range server.Resources {
+			resource := &server.Resources[j]
+			name := m.resourceName(server.Name, resource.Name)
+			_, explicit := m.Resources[server.Name+"/"+resource.Name]
+			// An explicit mapping names the resource on the broker as well
+			switch {
+			case resource.ResourceName == "":
+			case explicit || resource.ResourceName == resource.Name:
+				resource.ResourceName = name
+			default:
+				resource.ResourceName = m.replacePrefix(resource.ResourceName)
+			}
+			resource.Name = name
+		}
+		for j := range server.Binds {
+			bind := &server.Binds[j]
+			bind.Source = m.resourceName(server.Name, bind.Source)
+			bind.Destination = m.resourceName(server.Name, bind.Destination)
+		}
+		server.Name = m.serverName(server.Name)
+	}
+
+	for i := range document.Apps {
+		app := &document.Apps[i]
+		for _, usages := range [][]contracts.ProvisionAppMessage{app.Sends, app.Receives} {
+			for j := range usages {
+				uri, err := contracts.ParseResourceURI(usages[j].Resource)
+				if err != nil {
+					return fmt.Errorf("apps[%d] (%s): %s", i, app.Name, err)
+				}
+				uri.Name = m.resourceName(uri.Server, uri.Name)
+				uri.Server = m.serverName(uri.Server)
+				usages[j].Resource = uri.String()
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Mapping) serverName(name string) string {
+	if mapped, ok := m.Servers[name]; ok {
+		return mapped
+	}
+	return name
+}
+
+// resourceName maps a resource of a server of the source project, explicit mappings take
+// precedence over prefixes
+func (m *Mapping) resourceName(server string, name string) string {
+	if mapped, ok := m.Resources[server+"/"+name]; ok {
+		return mapped
+	}
+	return m.replacePrefix(name)
+}
+
+// replacePrefix replaces the first matching prefix of a name
+func (m *Mapping) replacePrefix(name string) string {
+	for _, prefix := range m.Prefixes {
+		if strings.HasPrefix(name, prefix.From) {
+			return prefix.To + strings.TrimPrefix(name, prefix.From)
+		}
+	}
+	return name
+}
+
+// HoldBackIncompatibleSchemas keeps the schemas of the target project wherever the schema of
+// the same name in the promoted document breaks it. The held back schemas are replaced by the
+// ones of target in the document, and messages using them keep using the target's version.
+func HoldBackIncompatibleSchemas(document *contracts.ProvisionYAMLFile, target *contracts.ProvisionYAMLFile) ([]HeldBackSchema, error) {
+	targetSchemas := map[string]contracts.ProvisionSchema{}
+	for _, schema := range target.Schemas {
+		targetSchemas[schema.Name] = schema
+	}
+
+	var heldBack []HeldBackSchema
+	heldBackVersions := map[string]int{}
+	for i, schema := range document.Schemas {
+		current, ok := targetSchemas[schema.Name]
+		if !ok || strings.TrimSpace(current.Schema) == strings.TrimSpace(schema.Schema) {
+			continue
+		}
+
+		var issues []schemas.Incompatibility
+		if current.Type != schema.Type {
+			issues = []schemas.Incompatibility{{
+				Path:    "type",
+				Message: fmt.Sprintf("schema type changed from %s to %s", current.Type, schema.Type),
+			}}
+		} else {
+			var err error
+			issues, err = schemas.CheckCompatibility(schema.Type, current.Schema, schema.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check compatibility of schema %s: %s", schema.Name, err)
+			}
+		}
+		if len(issues) == 0 {
+			continue
+		}
+
+		heldBack = append(heldBack, HeldBackSchema{Name: schema.Name, Incompatibilities: issues})
+		heldBackVersions[schema.Name] = current.Version
+		document.Schemas[i] = current
+	}
+
+	targetMessages := map[string]contracts.ProvisionMessage{}
+	for _, message := range target.Messages {
+		targetMessages[message.Name] = message
+	}
+	for i, message := range document.Messages {
+		version, ok := heldBackVersions[message.Schema.Name]
+		if !ok {
+			continue
+		}
+		// Messages of the target project keep the schema version they use
+		if existing, ok := targetMessages[message.Name]; ok && existing.Schema.Name == message.Schema.Name {
+			document.Messages[i].Schema = existing.Schema
+		} else {
+			document.Messages[i].Schema.Version = version
+		}
+	}
+	return heldBack, nil
+}
+
+func sortedMapKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,177 @@
+package provision
+
+import (
+	"fmt"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/schemas"
+)
+
+// SupportedVersion is the version of the provision file format understood by paw
+const SupportedVersion = 1
+
+// Validate checks the provision document locally and returns all problems found in it
+func Validate(document *contracts.ProvisionYAMLFile) []error {
+	var problems []error
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Errorf(format, args...))
+	}
+
+	if document.Version != SupportedVersion {
+		report("version: unsupported provision file version %d, expected %d", document.Version, SupportedVersion)
+	}
+
+	servers := map[string]contracts.ProvisionServer{}
+	for i, server := range document.Servers {
+		if server.Name == "" {
+			report("servers[%d]: name is required", i)
+		} else if _, ok := servers[server.Name]; ok {
+			report("servers[%d]: duplicate server name %q", i, server.Name)
+		}
+		servers[server.Name] = server
+
+		typeErr := contracts.ValidateProtocol(server.Type)
+		if server.Type == "" {
+			report("servers[%d] (%s): type is required", i, server.Name)
+		} else if typeErr != nil {
+			report("servers[%d] (%s): %s", i, server.Name, typeErr)
+		}
+
+		for j, resource := range server.Resources {
+			uri := contracts.ResourceURI{
+				Protocol: server.Type,
+				Server:   server.Name,
+				Mode:     contracts.ResourceMode(resource.Mode),
+				Type:     contracts.ResourceType(resource.Type),
+				Name:     resource.Name,
+			}
+			if err := uri.Validate(); err != nil {
+				report("servers[%d] (%s): resources[%d]: %s", i, server.Name, j, err)
+			} else if typeErr == nil {
+				// The unknown type of a server is reported once, not for each of its resources
+				if err := contracts.ValidateResourceForProtocol(server.Type, uri.Type, uri.Mode); err != nil {
+					report("servers[%d] (%s): resources[%d] (%s): %s", i, server.Name, j, resource.Name, err)
+				}
+			}
+		}
+
+		resourceTypes := map[string]contracts.ResourceType{}
+		for _, resource := range server.Resources {
+			resourceTypes[resource.Name] = contracts.ResourceType(resource.Type)
+		}
+		for j, bind := range server.Binds {
+			sourceType, ok := resourceTypes[bind.Source]
+			if !ok {
+				report("servers[%d] (%s): binds[%d]: unknown source resource %q", i, server.Name, j, bind.Source)
+				continue
+			}
+			destinationType, ok := resourceTypes[bind.Destination]
+			if !ok {
+				report("servers[%d] (%s): binds[%d]: unknown destination resource %q", i, server.Name, j, bind.Destination)
+				continue
+			}
+			if err := contracts.ValidateBindForProtocol(server.Type, sourceType, destinationType); err != nil {
+				report("servers[%d] (%s): binds[%d]: %s", i, server.Name, j, err)
+			}
+		}
+	}
+
+	schemaNames := map[string]bool{}
+	schemaTypes := map[string]contracts.SchemaType{}
+	for i, schema := range document.Schemas {
+		if schema.Name == "" {
+			report("schemas[%d]: name is required", i)
+		} else if schemaNames[schema.Name] {
+			report("schemas[%d]: duplicate schema name %q", i, schema.Name)
+		}
+		schemaNames[schema.Name] = true
+		schemaTypes[schema.Name] = schema.Type
+
+		schemaType, err := schemas.ParseType(string(schema.Type))
+		if err != nil {
+			report("schemas[%d] (%s): %s", i, schema.Name, err)
+			continue
+		}
+		if _, err := schemas.Normalize(schemaType, schema.Schema); err != nil {
+			report("schemas[%d] (%s): %s", i, schema.Name, err)
+		}
+	}
+
+	messageNames := map[string]bool{}
+	for i, message := range document.Messages {
+		if message.Name == "" {
+			report("messages[%d]: name is required", i)
+		} else if messageNames[message.Name] {
+			report("messages[%d]: duplicate message name %q", i, message.Name)
+		}
+		messageNames[message.Name] = true
+
+		if !schemaNames[message.Schema.Name] {
+			report("messages[%d] (%s): unknown schema %q", i, message.Name, message.Schema.Name)
+		}
+		if message.CloudEvents != nil && message.CloudEvents.Type == "" {
+			report("messages[%d] (%s): cloudevents type is required", i, message.Name)
+		}
+		// The envelope is described as a JSON Schema, so only JSON data can be put inside it
+		if schemaType, ok := schemaTypes[message.Schema.Name]; ok && message.CloudEvents != nil && schemaType != contracts.SchemaTypeJSONSchema {
+			report("messages[%d] (%s): cloudevents is only supported for jsonschema schemas, schema %q is %s", i, message.Name, message.Schema.Name, schemaType)
+		}
+	}
+
+	appNames := map[string]bool{}
+	for i, app := range document.Apps {
+		if app.Name == "" {
+			report("apps[%d]: name is required", i)
+		} else if appNames[app.Name] {
+			report("apps[%d]: duplicate app name %q", i, app.Name)
+		}
+		appNames[app.Name] = true
+
+		for j, send := range app.Sends {
+			if !messageNames[send.Message] {
+				report("apps[%d] (%s): sends[%d]: unknown message %q", i, app.Name, j, send.Message)
+			}
+			if err := validateResourceReference(servers, send.Resource); err != nil {
+				report("apps[%d] (%s): sends[%d]: %s", i, app.Name, j, err)
+			}
+		}
+		for j, receive := range app.Receives {
+			if !messageNames[receive.Message] {
+				report("apps[%d] (%s): receives[%d]: unknown message %q", i, app.Name, j, receive.Message)
+			}
+			if err := validateResourceReference(servers, receive.Resource); err != nil {
+				report("apps[%d] (%s): receives[%d]: %s", i, app.Name, j, err)
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateResourceReference checks that a resource URI points to a resource declared in the document
+func validateResourceReference(servers map[string]contracts.ProvisionServer, raw string) error {
+	uri, err := contracts.ParseResourceURI(raw)
+	if err != nil {
+		return err
+	}
+
+	server, ok := servers[uri.Server]
+	if !ok {
+		return fmt.Errorf("resource URI %s references unknown server %q", uri, uri.Server)
+	}
+	if err := uri.ValidateForServer(server.Name, server.Type); err != nil {
+		return err
+	}
+
+	for _, resource := range server.Resources {
+		if resource.Name != uri.Name {
+			continue
+		}
+		if resource.Type != string(uri.Type) || resource.Mode != string(uri.Mode) {
+			return fmt.Errorf("resource URI %s does not match resource %q of server %q (%s, %s)", uri, resource.Name, server.Name, resource.Type, resource.Mode)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("resource URI %s references unknown resource %q of server %q", uri, uri.Name, server.Name)
+}
@@ -1,6 +1,8 @@
 package router
 
 import (
+	"time"
+
 	"github.com/fusioncatalyst/paw/actions"
 	"github.com/urfave/cli/v3"
 )
@@ -155,6 +157,146 @@ func GetCLIRouter() *cli.Command {
 							},
 						},
 					},
+					{
+						Name:        "sends",
+						Usage:       "Manage messages the app sends",
+						Description: "Add or remove messages an app sends through a resource",
+						Commands: []*cli.Command{
+							{
+								Name:   "add",
+								Usage:  "Add a message the app sends",
+								Action: actions.AddAppSendAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "project-id",
+										Usage:    "The ID of the project the app belongs to",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "app",
+										Usage:    "Name of the app",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "message",
+										Usage:    "Name of the message",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "resource",
+										Usage:    "Resource URI (protocol://server@mode/type/name) the message is sent to",
+										Required: true,
+									},
+								},
+							},
+							{
+								Name:   "remove",
+								Usage:  "Remove a message the app sends",
+								Action: actions.RemoveAppSendAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "project-id",
+										Usage:    "The ID of the project the app belongs to",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "app",
+										Usage:    "Name of the app",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "message",
+										Usage:    "Name of the message",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "resource",
+										Usage:    "Resource URI (protocol://server@mode/type/name) the message is sent to",
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+					{
+						Name:        "receives",
+						Usage:       "Manage messages the app receives",
+						Description: "Add or remove messages an app receives through a resource",
+						Commands: []*cli.Command{
+							{
+								Name:   "add",
+								Usage:  "Add a message the app receives",
+								Action: actions.AddAppReceiveAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "project-id",
+										Usage:    "The ID of the project the app belongs to",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "app",
+										Usage:    "Name of the app",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "message",
+										Usage:    "Name of the message",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "resource",
+										Usage:    "Resource URI (protocol://server@mode/type/name) the message is received from",
+										Required: true,
+									},
+								},
+							},
+							{
+								Name:   "remove",
+								Usage:  "Remove a message the app receives",
+								Action: actions.RemoveAppReceiveAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "project-id",
+										Usage:    "The ID of the project the app belongs to",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "app",
+										Usage:    "Name of the app",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "message",
+										Usage:    "Name of the message",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "resource",
+										Usage:    "Resource URI (protocol://server@mode/type/name) the message is received from",
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+					{
+						Name:        "describe",
+						Usage:       "Show the communication contract of an app",
+						Description: "Show all messages an app sends and receives, with their schemas and resources",
+						Action:      actions.DescribeAppAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project the app belongs to",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "app",
+								Usage:    "Name of the app",
+								Required: true,
+							},
+						},
+					},
 				},
 			},
 			{
@@ -198,12 +340,12 @@ func GetCLIRouter() *cli.Command {
 							},
 							&cli.StringFlag{
 								Name:     "type",
-								Usage:    "Type of the schema (e.g., jsonschema)",
+								Usage:    "Type of the schema (jsonschema, avro, protobuf)",
 								Required: true,
 							},
 							&cli.StringFlag{
 								Name:     "schema-file",
-								Usage:    "Path to a file containing the schema",
+								Usage:    "Path to a file containing the schema (local $refs of JSON schemas are bundled)",
 								Required: true,
 							},
 						},
@@ -214,6 +356,11 @@ func GetCLIRouter() *cli.Command {
 						Description: "Update an existing schema. Note: Only schema content can be updated. Schema name, type, and description cannot be changed.",
 						Action:      actions.UpdateSchemaAction,
 						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project the schema belongs to, used to validate the content according to the schema type",
+								Required: true,
+							},
 							&cli.StringFlag{
 								Name:     "schema-id",
 								Usage:    "The ID of the schema to update",
@@ -226,6 +373,85 @@ func GetCLIRouter() *cli.Command {
 							},
 						},
 					},
+					{
+						Name:        "bundle",
+						Usage:       "Bundle a multi-file JSON schema",
+						Description: "Resolve references to other local files and inline them into the $defs section of a single schema without uploading it",
+						Action:      actions.BundleSchemaAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "schema-file",
+								Usage:    "Path to the root schema file",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "out",
+								Usage: "Path to the output file (prints to stdout if omitted)",
+							},
+						},
+					},
+					{
+						Name:        "sample",
+						Usage:       "Generate sample documents from a schema",
+						Description: "Generate random or deterministic documents which are valid against a version of a JSON schema",
+						Action:      actions.SampleSchemaAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project the schema belongs to",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "schema-id",
+								Usage:    "The ID of the schema",
+								Required: true,
+							},
+							&cli.IntFlag{
+								Name:  "version",
+								Usage: "The version number of the schema (defaults to the latest version)",
+							},
+							&cli.IntFlag{
+								Name:  "count",
+								Usage: "Number of documents to generate",
+								Value: 1,
+							},
+							&cli.IntFlag{
+								Name:  "seed",
+								Usage: "Seed for deterministic output (random when omitted or 0)",
+							},
+							&cli.BoolFlag{
+								Name:  "ndjson",
+								Usage: "Print one compact document per line",
+							},
+						},
+					},
+					{
+						Name:        "check-compatibility",
+						Usage:       "Check compatibility of a schema file with an existing schema",
+						Description: "Compare a schema file with the latest (or a specific) version of a schema and report breaking changes",
+						Action:      actions.CheckSchemaCompatibilityAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project the schema belongs to",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "schema-id",
+								Usage:    "The ID of the schema to compare with",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "schema-file",
+								Usage:    "Path to a file containing the new schema content",
+								Required: true,
+							},
+							&cli.IntFlag{
+								Name:  "version",
+								Usage: "The version number to compare with (defaults to the latest version)",
+							},
+						},
+					},
 					{
 						Name:        "versions",
 						Usage:       "List all versions of a schema",
@@ -242,7 +468,7 @@ func GetCLIRouter() *cli.Command {
 					{
 						Name:        "get-version",
 						Usage:       "Get a specific version of a schema",
-						Description: "Retrieve a specific version of a schema by its schema ID and version ID",
+						Description: "Retrieve a specific version of a schema by its version ID, its version number or the latest one",
 						Action:      actions.GetSchemaVersionAction,
 						Flags: []cli.Flag{
 							&cli.StringFlag{
@@ -253,7 +479,20 @@ func GetCLIRouter() *cli.Command {
 							&cli.StringFlag{
 								Name:     "version-id",
 								Usage:    "The version ID of the schema",
-								Required: true,
+								Required: false,
+							},
+							&cli.IntFlag{
+								Name:     "version",
+								Usage:    "The version number of the schema (e.g., 3)",
+								Required: false,
+							},
+							&cli.BoolFlag{
+								Name:  "latest",
+								Usage: "Get the latest version of the schema",
+							},
+							&cli.BoolFlag{
+								Name:  "schema-only",
+								Usage: "Print only the decoded schema body as formatted JSON",
 							},
 						},
 					},
@@ -308,215 +547,1176 @@ func GetCLIRouter() *cli.Command {
 								Usage:    "The version of the schema to use",
 								Required: true,
 							},
+							&cli.BoolFlag{
+								Name:  "cloudevents",
+								Usage: "Mark the message as travelling inside a CloudEvents envelope",
+							},
+							&cli.StringFlag{
+								Name:  "ce-type",
+								Usage: "CloudEvents type attribute of the message, defaults to the message name",
+							},
+							&cli.StringFlag{
+								Name:  "ce-source",
+								Usage: "CloudEvents source attribute every event of the message must have",
+							},
+							&cli.StringFlag{
+								Name:  "ce-dataschema",
+								Usage: "CloudEvents dataschema attribute every event of the message must have",
+							},
 						},
 					},
-				},
-			},
-			{
-				Name:        "projects",
-				Usage:       "Manage projects",
-				Description: "List, create, and manage projects",
-				Commands: []*cli.Command{
-					{
-						Name:        "list",
-						Usage:       "List all projects",
-						Description: "Get information about all projects you have access to",
-						Action:      actions.ListProjectsAction,
-					},
 					{
-						Name:        "new",
-						Usage:       "Create a new project",
-						Description: "Create a new project with the specified name and description",
-						Action:      actions.CreateNewProjectAction,
+						Name:        "update",
+						Usage:       "Update a message",
+						Description: "Update the description of a message, the schema version it is pinned to or its CloudEvents attributes",
+						Action:      actions.UpdateMessageAction,
 						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project the message belongs to",
+								Required: true,
+							},
 							&cli.StringFlag{
 								Name:     "name",
-								Usage:    "Project name",
+								Usage:    "Name of the message",
 								Required: true,
 							},
+							&cli.StringFlag{
+								Name:  "description",
+								Usage: "New description of the message",
+							},
+							&cli.IntFlag{
+								Name:  "schema-version",
+								Usage: "The version of the schema to pin the message to",
+							},
 							&cli.BoolFlag{
-								Name:  "private",
-								Usage: "Make the project private",
+								Name:  "cloudevents",
+								Usage: "Mark the message as travelling inside a CloudEvents envelope, the --ce-* flags imply it",
 							},
 							&cli.StringFlag{
-								Name:     "belongs-to",
-								Usage:    "The project can belong to a user or an workspace (which is a group of users)",
-								Required: true,
+								Name:  "ce-type",
+								Usage: "CloudEvents type attribute of the message, defaults to the message name",
 							},
 							&cli.StringFlag{
-								Name:     "workspace-id",
-								Usage:    "If project belongs to a workspace, specify the workspace ID",
-								Required: false,
+								Name:  "ce-source",
+								Usage: "CloudEvents source attribute every event of the message must have",
 							},
 							&cli.StringFlag{
-								Name:  "description",
-								Usage: "Optional description of the project",
+								Name:  "ce-dataschema",
+								Usage: "CloudEvents dataschema attribute every event of the message must have",
 							},
 						},
 					},
 					{
-						Name:        "import",
-						Usage:       "Import project from file",
-						Description: "Import project definition from a file",
+						Name:        "bump",
+						Usage:       "Move messages to the newest version of their schema",
+						Description: "Re-point one message, or all messages of a schema, to the latest schema version. Apps sending or receiving the bumped messages are listed before applying",
+						Action:      actions.BumpMessagesAction,
 						Flags: []cli.Flag{
 							&cli.StringFlag{
-								Name:     "file",
-								Usage:    "Path to the file with project definition",
+								Name:     "project-id",
+								Usage:    "The ID of the project the messages belong to",
 								Required: true,
 							},
 							&cli.StringFlag{
-								Name:     "project-id",
-								Usage:    "The ID of the project to operate on",
-								Required: true,
+								Name:  "name",
+								Usage: "Name of the message to bump",
+							},
+							&cli.StringFlag{
+								Name:  "schema-id",
+								Usage: "Bump all messages using this schema",
+							},
+							&cli.BoolFlag{
+								Name:  "to-latest",
+								Usage: "Bump to the latest schema version",
+							},
+							&cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Only show what would be bumped",
+							},
+							&cli.BoolFlag{
+								Name:  "yes",
+								Usage: "Do not ask for confirmation",
 							},
 						},
-						Action: actions.ImportProjectAction,
 					},
 					{
-						Name:        "generate",
-						Usage:       "Generate code for project",
-						Description: "Generate code for a specific application in the project",
+						Name:        "validate",
+						Usage:       "Validate payloads against the schema of a message",
+						Description: "Validate JSON documents or NDJSON (from files or stdin) against the exact schema version of a message. Documents of CloudEvents messages must be structured mode envelopes, their data is validated against the schema",
+						Action:      actions.ValidateMessagePayloadsAction,
+						ArgsUsage:   "[payload files...]",
 						Flags: []cli.Flag{
 							&cli.StringFlag{
-								Name:     "app-id",
-								Usage:    "The ID of the application to generate code for",
+								Name:     "project-id",
+								Usage:    "The ID of the project the message belongs to",
 								Required: true,
 							},
 							&cli.StringFlag{
-								Name:     "project-id",
-								Usage:    "The ID of the project to operate on",
+								Name:     "message",
+								Usage:    "Name of the message",
 								Required: true,
 							},
+							&cli.StringSliceFlag{
+								Name:  "file",
+								Usage: "Path to a payload file (use - for stdin). Files can also be passed as arguments",
+							},
+							&cli.StringFlag{
+								Name:  "proto-message",
+								Usage: "Name of the message to validate against when the Protobuf schema declares several messages",
+							},
 						},
-						Action: actions.GenerateCodeAction,
-					},
-				},
-			},
-			{
-				Name:        "workspaces",
-				Usage:       "Manage workspaces",
-				Description: "List and create workspaces",
-				Commands: []*cli.Command{
-					{
-						Name:        "list",
-						Usage:       "List all workspaces",
-						Description: "Get information about all workspaces you have access to",
-						Action:      actions.ListWorkspacesAction,
 					},
 					{
-						Name:        "new",
-						Usage:       "Create a new workspace",
-						Description: "Create a new workspace with the specified name and description",
-						Action:      actions.CreateWorkspaceAction,
+						Name:        "sample",
+						Usage:       "Generate sample payloads for a message",
+						Description: "Generate random or deterministic payloads which are valid against the schema version of a message. Payloads of CloudEvents messages are wrapped into envelopes",
+						Action:      actions.SampleMessageAction,
 						Flags: []cli.Flag{
 							&cli.StringFlag{
-								Name:     "name",
-								Usage:    "Workspace name",
+								Name:     "project-id",
+								Usage:    "The ID of the project the message belongs to",
 								Required: true,
 							},
 							&cli.StringFlag{
-								Name:  "description",
-								Usage: "Optional description of the workspace",
+								Name:     "name",
+								Usage:    "Name of the message",
+								Required: true,
+							},
+							&cli.IntFlag{
+								Name:  "count",
+								Usage: "Number of documents to generate",
+								Value: 1,
+							},
+							&cli.IntFlag{
+								Name:  "seed",
+								Usage: "Seed for deterministic output (random when omitted or 0)",
+							},
+							&cli.BoolFlag{
+								Name:  "ndjson",
+								Usage: "Print one compact document per line",
 							},
 						},
 					},
 				},
 			},
 			{
-				Name:        "servers",
-				Usage:       "Manage servers",
-				Description: "List and create servers in projects",
+				Name:        "asyncapi",
+				Usage:       "Convert between projects and AsyncAPI documents",
+				Description: "Export projects as AsyncAPI documents and import AsyncAPI documents as project definitions",
 				Commands: []*cli.Command{
 					{
-						Name:        "list",
-						Usage:       "List all servers in a project",
-						Description: "Get information about all servers in a specific project",
-						Action:      actions.ListServers,
+						Name:        "export",
+						Usage:       "Export a project or an app as an AsyncAPI document",
+						Description: "Map servers to servers, resources to channels, messages and their schemas to components and the sends and receives of apps to operations",
+						Action:      actions.ExportAsyncAPIAction,
 						Flags: []cli.Flag{
 							&cli.StringFlag{
-								Name:     "project-id",
-								Usage:    "The ID of the project",
-								Required: true,
+								Name:  "project-id",
+								Usage: "The ID of the project to export",
+							},
+							&cli.StringFlag{
+								Name:  "file",
+								Usage: "Path to a project definition file to export instead of a live project",
+							},
+							&cli.StringFlag{
+								Name:  "app",
+								Usage: "Only export this app and what it sends and receives",
+							},
+							&cli.StringFlag{
+								Name:  "version",
+								Usage: "AsyncAPI version: 3.0.0 or 2.6.0",
+								Value: "3.0.0",
+							},
+							&cli.StringFlag{
+								Name:  "format",
+								Usage: "Output format: yaml or json",
+								Value: "yaml",
+							},
+							&cli.StringFlag{
+								Name:  "out",
+								Usage: "Write the document to this file instead of stdout",
 							},
+							varFlag(),
 						},
 					},
 					{
-						Name:        "new",
-						Usage:       "Create a new server",
-						Description: "Create a new server in the specified project",
-						Action:      actions.CreateServer,
+						Name:        "import",
+						Usage:       "Convert an AsyncAPI document into a project definition",
+						Description: "Convert servers, channels, messages and payload schemas of an AsyncAPI 2.x or 3.0 document into a project definition, reporting everything that cannot be represented",
+						ArgsUsage:   "<spec>",
+						Action:      actions.ImportAsyncAPIAction,
 						Flags: []cli.Flag{
 							&cli.StringFlag{
-								Name:     "project-id",
-								Usage:    "The ID of the project to create the server in",
-								Required: true,
+								Name:  "file",
+								Usage: "Path to the AsyncAPI document, if not given as an argument",
 							},
 							&cli.StringFlag{
-								Name:     "name",
-								Usage:    "Name of the server",
-								Required: true,
+								Name:  "out",
+								Usage: "Write the project definition to this file instead of stdout",
 							},
-							&cli.StringFlag{
-								Name:     "type",
-								Usage:    "Type of the server (e.g., async+kafka, async+amqp, async+webhook)",
-								Required: true,
+							&cli.BoolFlag{
+								Name:  "apply",
+								Usage: "Import the project definition into the project given by --project-id",
 							},
 							&cli.StringFlag{
-								Name:     "description",
-								Usage:    "Description of the server",
-								Required: true,
+								Name:  "project-id",
+								Usage: "The ID of the project to import into, used with --apply",
 							},
 						},
 					},
 				},
 			},
 			{
-				Name:        "resources",
-				Usage:       "Manage resources",
-				Description: "List and create resources in servers",
+				Name:        "docs",
+				Usage:       "Generate documentation",
+				Description: "Generate a browsable event catalog of a project",
 				Commands: []*cli.Command{
 					{
-						Name:        "list",
-						Usage:       "List all resources in a server",
-						Description: "Get information about all resources in a specific server",
-						Action:      actions.ListResourcesAction,
+						Name:        "generate",
+						Usage:       "Generate an event catalog",
+						Description: "Generate cross-linked pages for every app, message, schema and server of a project, as Markdown or as a static HTML site which works offline",
+						Action:      actions.GenerateDocsAction,
 						Flags: []cli.Flag{
 							&cli.StringFlag{
-								Name:     "server-id",
-								Usage:    "The ID of the server",
-								Required: true,
+								Name:  "project-id",
+								Usage: "The ID of the project to document",
 							},
-						},
-					},
-					{
-						Name:        "new",
-						Usage:       "Create a new resource",
-						Description: "Create a new resource in the specified server",
-						Action:      actions.CreateResourceAction,
-						Flags: []cli.Flag{
 							&cli.StringFlag{
-								Name:     "server-id",
-								Usage:    "The ID of the server to create the resource in",
-								Required: true,
+								Name:  "file",
+								Usage: "Path to a project definition file to document instead of a live project",
 							},
 							&cli.StringFlag{
-								Name:     "name",
-								Usage:    "Name of the resource",
-								Required: true,
+								Name:  "out",
+								Usage: "Directory to write the catalog to",
+								Value: "site",
 							},
 							&cli.StringFlag{
-								Name:     "description",
-								Usage:    "Description of the resource",
-								Required: false,
+								Name:  "format",
+								Usage: "Output format: markdown or html",
+								Value: "markdown",
 							},
 							&cli.StringFlag{
-								Name:     "type",
-								Usage:    "Type of the resource (topic, exchange, queue, table, endpoint)",
-								Required: true,
+								Name:  "title",
+								Usage: "Title of the catalog",
 							},
-							&cli.StringFlag{
-								Name:     "mode",
-								Usage:    "Mode of the resource (read, write, bind, readwrite)",
+							varFlag(),
+						},
+					},
+				},
+			},
+			{
+				Name:        "impact",
+				Usage:       "Show what is affected by a schema or message change",
+				Description: "Walk schema -> messages -> apps -> resources and list every affected app and generated artifact. With --schema-file the change is checked for compatibility per message",
+				Action:      actions.ImpactAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "project-id",
+						Usage:    "The ID of the project to analyse",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "schema",
+						Usage: "Name of the changed schema",
+					},
+					&cli.StringFlag{
+						Name:  "message",
+						Usage: "Name of the changed message",
+					},
+					&cli.StringFlag{
+						Name:  "schema-file",
+						Usage: "Path to the new schema, compared with the schema version of every affected message",
+					},
+					&cli.BoolFlag{
+						Name:  "breaking-only",
+						Usage: "Only show messages for which the new schema is a breaking change",
+					},
+				},
+			},
+			{
+				Name:        "lint",
+				Usage:       "Lint a project definition",
+				Description: "Check a project definition against naming, documentation and design rules. Severities can be changed per rule in a .pawlint.yaml file. Exits with an error when any rule with severity error is violated",
+				Action:      actions.LintAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "project-id",
+						Usage: "The ID of the project to lint",
+					},
+					&cli.StringFlag{
+						Name:  "file",
+						Usage: "Path to a project definition file to lint instead of a live project",
+					},
+					&cli.StringFlag{
+						Name:  "config",
+						Usage: "Path to the lint configuration, defaults to .pawlint.yaml in the working directory",
+					},
+					&cli.StringFlag{
+						Name:  "format",
+						Usage: "Output format: text, json or sarif",
+						Value: "text",
+					},
+					&cli.StringFlag{
+						Name:  "out",
+						Usage: "Path to write the results to, defaults to stdout",
+					},
+					varFlag(),
+				},
+			},
+			{
+				Name:        "dev",
+				Usage:       "Watch a project definition during design sessions",
+				Description: "Watch a project definition and the files it includes and references. On every save the definition is validated, applied to a sandbox project and the code of the given apps is regenerated",
+				Action:      actions.DevAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Usage:    "Path to the project definition file to watch",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "project-id",
+						Usage:    "The ID of the sandbox project the definition is applied to",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:  "app",
+						Usage: "Name of an app to regenerate code for. Can be repeated",
+					},
+					&cli.StringFlag{
+						Name:  "language",
+						Usage: "Language of the generated code, defaults to the language of fcsettings.yaml",
+					},
+					&cli.DurationFlag{
+						Name:  "interval",
+						Usage: "How often files are checked for changes",
+						Value: 500 * time.Millisecond,
+					},
+					&cli.DurationFlag{
+						Name:  "debounce",
+						Usage: "How long files must stay unchanged before a change is applied",
+						Value: 300 * time.Millisecond,
+					},
+					&cli.BoolFlag{
+						Name:  "once",
+						Usage: "Apply the definition once and exit instead of watching it",
+					},
+					varFlag(),
+				},
+			},
+			{
+				Name:        "projects",
+				Usage:       "Manage projects",
+				Description: "List, create, and manage projects",
+				Commands: []*cli.Command{
+					{
+						Name:        "list",
+						Usage:       "List all projects",
+						Description: "Get information about all projects you have access to",
+						Action:      actions.ListProjectsAction,
+					},
+					{
+						Name:        "new",
+						Usage:       "Create a new project",
+						Description: "Create a new project with the specified name and description",
+						Action:      actions.CreateNewProjectAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "name",
+								Usage:    "Project name",
+								Required: true,
+							},
+							&cli.BoolFlag{
+								Name:  "private",
+								Usage: "Make the project private",
+							},
+							&cli.StringFlag{
+								Name:     "belongs-to",
+								Usage:    "The project can belong to a user or an workspace (which is a group of users)",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "workspace-id",
+								Usage:    "If project belongs to a workspace, specify the workspace ID",
+								Required: false,
+							},
+							&cli.StringFlag{
+								Name:  "description",
+								Usage: "Optional description of the project",
+							},
+						},
+					},
+					{
+						Name:        "get",
+						Usage:       "Show a project",
+						Description: "Show a project with the number of apps, schemas, messages and servers it contains",
+						Action:      actions.GetProjectAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to show",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "update",
+						Usage:       "Update a project",
+						Description: "Rename a project or change its description or visibility",
+						Action:      actions.UpdateProjectAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to update",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "name",
+								Usage: "New project name",
+							},
+							&cli.StringFlag{
+								Name:  "description",
+								Usage: "New project description",
+							},
+							&cli.StringFlag{
+								Name:  "visibility",
+								Usage: "Make the project private or public",
+							},
+						},
+					},
+					{
+						Name:        "archive",
+						Usage:       "Archive a project",
+						Description: "Archive a project which is no longer worked on. Archived projects are kept but can no longer be changed. The project name has to be typed to confirm",
+						Action:      actions.ArchiveProjectAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to archive",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "confirm",
+								Usage: "The name of the project, to confirm archiving without a prompt",
+							},
+						},
+					},
+					{
+						Name:        "delete",
+						Usage:       "Delete a project",
+						Description: "Delete a project with all its servers, schemas, messages and apps. The project name has to be typed to confirm",
+						Action:      actions.DeleteProjectAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to delete",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "confirm",
+								Usage: "The name of the project, to confirm the deletion without a prompt",
+							},
+						},
+					},
+					{
+						Name:        "transfer",
+						Usage:       "Transfer a project to another owner",
+						Description: "Move a project into a workspace, or back to a user, and show the ownership change",
+						Action:      actions.TransferProjectAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to transfer",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "to-workspace",
+								Usage: "ID or name of the workspace to transfer the project to",
+							},
+							&cli.StringFlag{
+								Name:  "to-user",
+								Usage: "ID or email of the user to transfer the project to, a member of one of your workspaces, or \"me\"",
+							},
+							&cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Only show the ownership change without transferring the project",
+							},
+							&cli.BoolFlag{
+								Name:  "yes",
+								Usage: "Do not ask for confirmation",
+							},
+						},
+					},
+					{
+						Name:        "import",
+						Usage:       "Import project from file",
+						Description: "Import project definition from a file. Included files are merged and ${VAR} references are substituted and the definition is validated locally before it is uploaded. Unknown keys are rejected",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "file",
+								Usage:    "Path to the file with project definition",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to operate on",
+								Required: true,
+							},
+							varFlag(),
+						},
+						Action: actions.ImportProjectAction,
+					},
+					{
+						Name:        "validate",
+						Usage:       "Validate project definition file",
+						Description: "Validate a project definition file locally, including the content of its schemas",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "file",
+								Usage:    "Path to the file with project definition",
+								Required: true,
+							},
+							varFlag(),
+						},
+						Action: actions.ValidateProjectFileAction,
+					},
+					{
+						Name:        "graph",
+						Usage:       "Render the project topology",
+						Description: "Render apps, resources and servers of a project with producer -> resource -> consumer edges, from the live project or a local project definition file",
+						Action:      actions.ProjectGraphAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "project-id",
+								Usage: "The ID of the project to render",
+							},
+							&cli.StringFlag{
+								Name:  "file",
+								Usage: "Path to a local project definition file, instead of --project-id",
+							},
+							&cli.StringFlag{
+								Name:  "format",
+								Usage: "Output format (dot, mermaid, plantuml, json)",
+								Value: "dot",
+							},
+							&cli.StringFlag{
+								Name:  "app",
+								Usage: "Only show the flows of this app and the apps it talks to",
+							},
+							&cli.StringFlag{
+								Name:  "server",
+								Usage: "Only show the flows going through this server",
+							},
+							&cli.StringFlag{
+								Name:  "out",
+								Usage: "Write the graph to this file instead of stdout",
+							},
+							varFlag(),
+						},
+					},
+					{
+						Name:        "export",
+						Usage:       "Export project to file",
+						Description: "Export the definition of an existing project in the import file format",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to operate on",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "out",
+								Usage: "Path to the output file (prints to stdout if omitted)",
+							},
+						},
+						Action: actions.ExportProjectAction,
+					},
+					{
+						Name:        "promote",
+						Usage:       "Promote a project to another environment",
+						Description: "Apply the schemas, messages, apps and servers of one project to another, e.g. from staging to prod. Server and resource names can be mapped per environment with a mapping file, and the changes are shown before they are applied",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "from",
+								Usage:    "The ID or name of the project to promote",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "to",
+								Usage:    "The ID or name of the project to promote to",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "mapping",
+								Usage: "Path to a YAML file mapping server and resource names of the source project to the target project",
+							},
+							&cli.BoolFlag{
+								Name:  "compatible-only",
+								Usage: "Keep the schemas of the target project which the promoted schema versions are not compatible with",
+							},
+							&cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Only show what would be changed",
+							},
+							&cli.BoolFlag{
+								Name:  "yes",
+								Usage: "Do not ask for confirmation",
+							},
+						},
+						Action: actions.PromoteProjectAction,
+					},
+					{
+						Name:        "generate",
+						Usage:       "Generate code for project",
+						Description: "Generate code for a specific application in the project, wait for the generation to finish and download the generated files into the code generation output directory",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "app-id",
+								Usage:    "The ID of the application to generate code for",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to operate on",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "out",
+								Usage: "Directory to download the generated files to, defaults to the outputDir of fcsettings.yaml or fusioncat",
+							},
+							&cli.DurationFlag{
+								Name:  "timeout",
+								Usage: "How long to wait for the generation to finish",
+								Value: 5 * time.Minute,
+							},
+							&cli.BoolFlag{
+								Name:  "no-wait",
+								Usage: "Print the started generation instead of waiting for it and downloading the files",
+							},
+						},
+						Action: actions.GenerateCodeAction,
+					},
+				},
+			},
+			{
+				Name:        "workspaces",
+				Usage:       "Manage workspaces",
+				Description: "List, create, update and delete workspaces, and manage their members",
+				Commands: []*cli.Command{
+					{
+						Name:        "list",
+						Usage:       "List all workspaces",
+						Description: "Get information about all workspaces you have access to",
+						Action:      actions.ListWorkspacesAction,
+					},
+					{
+						Name:        "new",
+						Usage:       "Create a new workspace",
+						Description: "Create a new workspace with the specified name and description",
+						Action:      actions.CreateWorkspaceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "name",
+								Usage:    "Workspace name",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "description",
+								Usage: "Optional description of the workspace",
+							},
+						},
+					},
+					{
+						Name:        "get",
+						Usage:       "Get a workspace",
+						Description: "Get information about a specific workspace",
+						Action:      actions.GetWorkspaceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "workspace-id",
+								Usage:    "The ID of the workspace",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "update",
+						Usage:       "Update a workspace",
+						Description: "Update the name or description of a workspace",
+						Action:      actions.UpdateWorkspaceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "workspace-id",
+								Usage:    "The ID of the workspace",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "name",
+								Usage: "New name of the workspace",
+							},
+							&cli.StringFlag{
+								Name:  "description",
+								Usage: "New description of the workspace",
+							},
+						},
+					},
+					{
+						Name:        "delete",
+						Usage:       "Delete a workspace",
+						Description: "Delete a workspace after confirming its name",
+						Action:      actions.DeleteWorkspaceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "workspace-id",
+								Usage:    "The ID of the workspace",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "confirm",
+								Usage: "The name of the workspace, to confirm the deletion without a prompt",
+							},
+						},
+					},
+					{
+						Name:        "projects",
+						Usage:       "List projects in a workspace",
+						Description: "Get information about all projects that belong to a workspace",
+						Action:      actions.ListWorkspaceProjectsAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "workspace-id",
+								Usage:    "The ID of the workspace",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "invite",
+						Usage:       "Invite someone to a workspace",
+						Description: "Send an invitation to join a workspace to an email address",
+						Action:      actions.InviteToWorkspaceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "workspace-id",
+								Usage:    "The ID of the workspace",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "email",
+								Usage:    "Email address to invite",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "role",
+								Usage: "Role of the invited member (owner, admin or member)",
+								Value: "member",
+							},
+						},
+					},
+					{
+						Name:        "members",
+						Usage:       "Manage workspace members",
+						Description: "List, add and remove workspace members and change their roles",
+						Commands: []*cli.Command{
+							{
+								Name:        "list",
+								Usage:       "List members of a workspace",
+								Description: "Get information about all members of a workspace and their roles",
+								Action:      actions.ListWorkspaceMembersAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "workspace-id",
+										Usage:    "The ID of the workspace",
+										Required: true,
+									},
+								},
+							},
+							{
+								Name:        "add",
+								Usage:       "Add a member to a workspace",
+								Description: "Add an existing user to a workspace with the given role",
+								Action:      actions.AddWorkspaceMemberAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "workspace-id",
+										Usage:    "The ID of the workspace",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:     "email",
+										Usage:    "Email address of the user",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:  "role",
+										Usage: "Role of the member (owner, admin or member)",
+										Value: "member",
+									},
+								},
+							},
+							{
+								Name:        "remove",
+								Usage:       "Remove a member from a workspace",
+								Description: "Remove a member, identified by user ID or email, from a workspace",
+								Action:      actions.RemoveWorkspaceMemberAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "workspace-id",
+										Usage:    "The ID of the workspace",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:  "user-id",
+										Usage: "The ID of the user",
+									},
+									&cli.StringFlag{
+										Name:  "email",
+										Usage: "Email address of the user",
+									},
+									&cli.BoolFlag{
+										Name:  "yes",
+										Usage: "Do not ask for confirmation",
+									},
+								},
+							},
+							{
+								Name:        "set-role",
+								Usage:       "Change the role of a workspace member",
+								Description: "Change the role of a member, identified by user ID or email",
+								Action:      actions.SetWorkspaceMemberRoleAction,
+								Flags: []cli.Flag{
+									&cli.StringFlag{
+										Name:     "workspace-id",
+										Usage:    "The ID of the workspace",
+										Required: true,
+									},
+									&cli.StringFlag{
+										Name:  "user-id",
+										Usage: "The ID of the user",
+									},
+									&cli.StringFlag{
+										Name:  "email",
+										Usage: "Email address of the user",
+									},
+									&cli.StringFlag{
+										Name:     "role",
+										Usage:    "New role of the member (owner, admin or member)",
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:        "servers",
+				Usage:       "Manage servers",
+				Description: "List, create, update and delete servers in projects",
+				Commands: []*cli.Command{
+					{
+						Name:        "list",
+						Usage:       "List all servers in a project",
+						Description: "Get information about all servers in a specific project",
+						Action:      actions.ListServers,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "new",
+						Usage:       "Create a new server",
+						Description: "Create a new server in the specified project",
+						Action:      actions.CreateServer,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "project-id",
+								Usage:    "The ID of the project to create the server in",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "name",
+								Usage:    "Name of the server",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "type",
+								Usage:    "Type of the server (async+kafka, async+amqp, async+webhook)",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "description",
+								Usage:    "Description of the server",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "get",
+						Usage:       "Show a server",
+						Description: "Get information about a specific server",
+						Action:      actions.GetServer,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "server-id",
+								Usage:    "The ID of the server",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "update",
+						Usage:       "Update a server",
+						Description: "Change the name or description of a server",
+						Action:      actions.UpdateServer,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "server-id",
+								Usage:    "The ID of the server",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "name",
+								Usage: "New name of the server",
+							},
+							&cli.StringFlag{
+								Name:  "description",
+								Usage: "New description of the server",
+							},
+							&cli.BoolFlag{
+								Name:  "yes",
+								Usage: "Do not ask for confirmation",
+							},
+						},
+					},
+					{
+						Name:        "delete",
+						Usage:       "Delete a server",
+						Description: "Delete a server together with its resources. Refuses while apps send or receive messages through its resources",
+						Action:      actions.DeleteServer,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "server-id",
+								Usage:    "The ID of the server",
+								Required: true,
+							},
+							&cli.BoolFlag{
+								Name:  "force",
+								Usage: "Delete even if the resources are still in use",
+							},
+							&cli.BoolFlag{
+								Name:  "yes",
+								Usage: "Do not ask for confirmation",
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:        "resources",
+				Usage:       "Manage resources",
+				Description: "List, create, update and delete resources in servers",
+				Commands: []*cli.Command{
+					{
+						Name:        "list",
+						Usage:       "List all resources in a server",
+						Description: "Get information about all resources in a specific server",
+						Action:      actions.ListResourcesAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "server-id",
+								Usage:    "The ID of the server",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "new",
+						Usage:       "Create a new resource",
+						Description: "Create a new resource in the specified server, either from flags or from a resource URI like async+kafka://mainkafka@readwrite/topic/emails",
+						Action:      actions.CreateResourceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "server-id",
+								Usage: "The ID of the server to create the resource in",
+							},
+							&cli.StringFlag{
+								Name:  "name",
+								Usage: "Name of the resource",
+							},
+							&cli.StringFlag{
+								Name:     "description",
+								Usage:    "Description of the resource",
+								Required: false,
+							},
+							&cli.StringFlag{
+								Name:  "type",
+								Usage: "Type of the resource (topic, exchange, queue, table, endpoint), must be supported by the server protocol",
+							},
+							&cli.StringFlag{
+								Name:  "mode",
+								Usage: "Mode of the resource (read, write, bind, readwrite)",
+							},
+							&cli.StringFlag{
+								Name:  "resource",
+								Usage: "Resource URI (protocol://server@mode/type/name) replacing --server-id, --name, --type and --mode. Requires --project-id",
+							},
+							&cli.StringFlag{
+								Name:  "project-id",
+								Usage: "The ID of the project the server belongs to, required with --resource",
+							},
+						},
+					},
+					{
+						Name:        "get",
+						Usage:       "Show a resource",
+						Description: "Get information about a specific resource",
+						Action:      actions.GetResourceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "resource-id",
+								Usage: "The ID of the resource",
+							},
+							&cli.StringFlag{
+								Name:  "resource",
+								Usage: "Resource URI (protocol://server@mode/type/name), instead of --resource-id. Requires --project-id",
+							},
+							&cli.StringFlag{
+								Name:  "project-id",
+								Usage: "The ID of the project the resource belongs to, required with --resource",
+							},
+						},
+					},
+					{
+						Name:        "update",
+						Usage:       "Update a resource",
+						Description: "Change the name, description or mode of a resource",
+						Action:      actions.UpdateResourceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "resource-id",
+								Usage: "The ID of the resource",
+							},
+							&cli.StringFlag{
+								Name:  "resource",
+								Usage: "Resource URI (protocol://server@mode/type/name), instead of --resource-id. Requires --project-id",
+							},
+							&cli.StringFlag{
+								Name:  "project-id",
+								Usage: "The ID of the project the resource belongs to, required with --resource",
+							},
+							&cli.StringFlag{
+								Name:  "name",
+								Usage: "New name of the resource",
+							},
+							&cli.StringFlag{
+								Name:  "description",
+								Usage: "New description of the resource",
+							},
+							&cli.StringFlag{
+								Name:  "mode",
+								Usage: "New mode of the resource (read, write, bind, readwrite), must be supported by the server protocol",
+							},
+							&cli.BoolFlag{
+								Name:  "yes",
+								Usage: "Do not ask for confirmation",
+							},
+						},
+					},
+					{
+						Name:        "delete",
+						Usage:       "Delete a resource",
+						Description: "Delete a resource. Refuses while apps send or receive messages through it",
+						Action:      actions.DeleteResourceAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "resource-id",
+								Usage: "The ID of the resource",
+							},
+							&cli.StringFlag{
+								Name:  "resource",
+								Usage: "Resource URI (protocol://server@mode/type/name), instead of --resource-id. Requires --project-id",
+							},
+							&cli.StringFlag{
+								Name:  "project-id",
+								Usage: "The ID of the project the resource belongs to, required with --resource",
+							},
+							&cli.BoolFlag{
+								Name:  "force",
+								Usage: "Delete even if the resource is still in use",
+							},
+							&cli.BoolFlag{
+								Name:  "yes",
+								Usage: "Do not ask for confirmation",
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:        "bindings",
+				Usage:       "Manage bindings",
+				Description: "List, create and delete exchange to queue bindings of AMQP servers",
+				Commands: []*cli.Command{
+					{
+						Name:        "list",
+						Usage:       "List all bindings of a server",
+						Description: "Get information about all bindings of a specific server",
+						Action:      actions.ListBindingsAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "server-id",
+								Usage:    "The ID of the server",
+								Required: true,
+							},
+						},
+					},
+					{
+						Name:        "new",
+						Usage:       "Create a new binding",
+						Description: "Bind an exchange to a queue of the same server",
+						Action:      actions.CreateBindingAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "server-id",
+								Usage:    "The ID of the server",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "source",
+								Usage:    "Name of the source exchange",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "destination",
+								Usage:    "Name of the destination queue",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "routing-key",
+								Usage: "Routing key of the binding",
+							},
+						},
+					},
+					{
+						Name:        "delete",
+						Usage:       "Delete a binding",
+						Description: "Delete a binding from a server",
+						Action:      actions.DeleteBindingAction,
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "server-id",
+								Usage:    "The ID of the server",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "bind-id",
+								Usage:    "The ID of the binding",
 								Required: true,
 							},
 						},
@@ -528,3 +1728,12 @@ func GetCLIRouter() *cli.Command {
 
 	return cmd
 }
+
+// varFlag sets variables for the project definition file of commands which load one. Every
+// command gets its own flag, flags keep the values they were parsed with.
+func varFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:  "var",
+		Usage: "Set a variable used as ${key} in the project definition file, as key=value. Can be repeated",
+	}
+}
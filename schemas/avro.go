@@ -0,0 +1,241 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+// avroPromotions lists primitive type changes which readers can resolve according to the Avro specification
+var avroPromotions = map[string][]string{
+	"int":    {"long", "float", "double"},
+	"long":   {"float", "double"},
+	"float":  {"double"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+// normalizeAvro checks that the content is a valid Avro schema (.avsc) and returns it compacted
+func normalizeAvro(content string) (string, error) {
+	var schemaJSON interface{}
+	if err := json.Unmarshal([]byte(content), &schemaJSON); err != nil {
+		return "", errors.New("invalid schema content: " + err.Error())
+	}
+
+	if _, err := goavro.NewCodec(content); err != nil {
+		return "", errors.New("invalid Avro schema: " + err.Error())
+	}
+
+	escapedSchema, err := json.Marshal(schemaJSON)
+	if err != nil {
+		return "", errors.New("failed to escape schema content: " + err.Error())
+	}
+
+	return string(escapedSchema), nil
+}
+
+// checkAvroCompatibility reports changes which prevent readers of one version of the
+// schema from decoding data written with the other one
+func checkAvroCompatibility(previous string, next string) ([]Incompatibility, error) {
+	var previousSchema, nextSchema interface{}
+	if err := json.Unmarshal([]byte(previous), &previousSchema); err != nil {
+		return nil, errors.New("invalid previous schema: " + err.Error())
+	}
+	if err := json.Unmarshal([]byte(next), &nextSchema); err != nil {
+		return nil, errors.New("invalid new schema: " + err.Error())
+	}
+
+	var issues []Incompatibility
+	compareAvroSchemas(avroSchemaName(previousSchema, "$"), previousSchema, nextSchema, &issues)
+	return issues, nil
+}
+
+func compareAvroSchemas(path string, previous interface{}, next interface{}, issues *[]Incompatibility) {
+	previousType := avroTypeName(previous)
+	nextType := avroTypeName(next)
+
+	if previousType == "union" || nextType == "union" {
+		compareAvroUnions(path, previous, next, issues)
+		return
+	}
+
+	if previousType != nextType {
+		if !isAvroPromotion(previousType, nextType) {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("type changed from %s to %s", previousType, nextType),
+			})
+		}
+		return
+	}
+
+	previousObject, _ := previous.(map[string]interface{})
+	nextObject, _ := next.(map[string]interface{})
+	if previousObject == nil || nextObject == nil {
+		return
+	}
+
+	switch previousType {
+	case "record", "error":
+		compareAvroRecords(path, previousObject, nextObject, issues)
+	case "enum":
+		previousSymbols := avroStrings(previousObject["symbols"])
+		nextSymbols := avroStrings(nextObject["symbols"])
+		_, nextHasDefault := nextObject["default"]
+		_, previousHasDefault := previousObject["default"]
+		for _, symbol := range previousSymbols {
+			if !containsString(nextSymbols, symbol) && !nextHasDefault {
+				*issues = append(*issues, Incompatibility{
+					Path:    path,
+					Message: fmt.Sprintf("enum symbol %q was removed", symbol),
+				})
+			}
+		}
+		for _, symbol := range nextSymbols {
+			if !containsString(previousSymbols, symbol) && !previousHasDefault {
+				*issues = append(*issues, Incompatibility{
+					Path:    path,
+					Message: fmt.Sprintf("enum symbol %q was added but the previous version has no default symbol", symbol),
+				})
+			}
+		}
+	case "array":
+		compareAvroSchemas(path+"[]", previousObject["items"], nextObject["items"], issues)
+	case "map":
+		compareAvroSchemas(path+"{}", previousObject["values"], nextObject["values"], issues)
+	case "fixed":
+		if fmt.Sprint(previousObject["size"]) != fmt.Sprint(nextObject["size"]) {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("fixed size changed from %v to %v", previousObject["size"], nextObject["size"]),
+			})
+		}
+	}
+}
+
+func compareAvroRecords(path string, previous map[string]interface{}, next map[string]interface{}, issues *[]Incompatibility) {
+	previousFields := avroFields(previous)
+	nextFields := avroFields(next)
+
+	for _, name := range sortedKeys(nextFields) {
+		if _, exists := previousFields[name]; exists {
+			continue
+		}
+		if _, hasDefault := nextFields[name]["default"]; !hasDefault {
+			*issues = append(*issues, Incompatibility{
+				Path:    path + "." + name,
+				Message: fmt.Sprintf("field %q was added without a default value", name),
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(previousFields) {
+		previousField := previousFields[name]
+		nextField, exists := nextFields[name]
+		if !exists {
+			if _, hasDefault := previousField["default"]; !hasDefault {
+				*issues = append(*issues, Incompatibility{
+					Path:    path + "." + name,
+					Message: fmt.Sprintf("field %q without a default value was removed", name),
+				})
+			}
+			continue
+		}
+		compareAvroSchemas(path+"."+name, previousField["type"], nextField["type"], issues)
+	}
+}
+
+func compareAvroUnions(path string, previous interface{}, next interface{}, issues *[]Incompatibility) {
+	previousBranches := avroUnionBranches(previous)
+	nextBranches := avroUnionBranches(next)
+
+	for _, branch := range previousBranches {
+		found := false
+		for _, candidate := range nextBranches {
+			if avroTypeName(candidate) == avroTypeName(branch) || isAvroPromotion(avroTypeName(branch), avroTypeName(candidate)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("type %s is no longer accepted", avroTypeName(branch)),
+			})
+		}
+	}
+}
+
+func avroUnionBranches(schema interface{}) []interface{} {
+	if branches, ok := schema.([]interface{}); ok {
+		return branches
+	}
+	return []interface{}{schema}
+}
+
+// avroTypeName returns the name of a type, named types are identified by their name
+func avroTypeName(schema interface{}) string {
+	switch value := schema.(type) {
+	case string:
+		return value
+	case []interface{}:
+		return "union"
+	case map[string]interface{}:
+		if typeName, ok := value["type"].(string); ok {
+			return typeName
+		}
+		return avroTypeName(value["type"])
+	default:
+		return "unknown"
+	}
+}
+
+func avroSchemaName(schema interface{}, fallback string) string {
+	if object, ok := schema.(map[string]interface{}); ok {
+		if name, ok := object["name"].(string); ok {
+			return name
+		}
+	}
+	return fallback
+}
+
+func avroFields(record map[string]interface{}) map[string]map[string]interface{} {
+	fields := map[string]map[string]interface{}{}
+	if list, ok := record["fields"].([]interface{}); ok {
+		for _, item := range list {
+			if field, ok := item.(map[string]interface{}); ok {
+				if name, ok := field["name"].(string); ok {
+					fields[name] = field
+				}
+			}
+		}
+	}
+	return fields
+}
+
+func avroStrings(value interface{}) []string {
+	var result []string
+	if list, ok := value.([]interface{}); ok {
+		for _, item := range list {
+			if text, ok := item.(string); ok {
+				result = append(result, text)
+			}
+		}
+	}
+	return result
+}
+
+func isAvroPromotion(from string, to string) bool {
+	return containsString(avroPromotions[from], to)
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,335 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var defNameSanitizer = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// bundler inlines local file references of a JSON Schema into its $defs section
+type bundler struct {
+	rootPath string
+	defs     map[string]interface{}
+	defNames map[string]string
+	taken    map[string]bool
+}
+
+// BundleFile reads a JSON Schema and resolves all `$ref`s pointing to local files.
+// Every referenced file is inlined once into the `$defs` section of the root schema
+// under a name derived from its file name, and the references are rewritten to point
+// there. Recursive references between files are preserved as recursive references
+// to the inlined definitions, while reference chains which never resolve to an actual
+// schema are reported as errors. References to remote URLs are left untouched.
+func BundleFile(filePath string) (string, error) {
+	content, _, err := BundleFileWithSources(filePath)
+	return content, err
+}
+
+// BundleFileWithSources bundles a JSON Schema like BundleFile and also returns the paths of
+// all files it read, starting with the root schema. The sources read before a failure are
+// returned together with the error.
+func BundleFileWithSources(filePath string) (string, []string, error) {
+	rootPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", nil, errors.New("failed to resolve schema file path: " + err.Error())
+	}
+
+	b := &bundler{
+		rootPath: rootPath,
+		defs:     map[string]interface{}{},
+		defNames: map[string]string{},
+		taken:    map[string]bool{},
+	}
+
+	content, err := b.bundle()
+	return content, b.sources(), err
+}
+
+func (b *bundler) bundle() (string, error) {
+	root, err := loadJSONFile(b.rootPath)
+	if err != nil {
+		return "", err
+	}
+
+	rootObject, isObject := root.(map[string]interface{})
+	existingDefs, _ := rootObject["$defs"].(map[string]interface{})
+	for name := range existingDefs {
+		b.taken[name] = true
+	}
+
+	bundled, err := b.rewrite(root, b.rootPath, "")
+	if err != nil {
+		return "", err
+	}
+
+	if len(b.defs) > 0 {
+		if !isObject {
+			return "", errors.New("only object schemas can reference other files")
+		}
+		bundledObject := bundled.(map[string]interface{})
+		defs, _ := bundledObject["$defs"].(map[string]interface{})
+		if defs == nil {
+			defs = map[string]interface{}{}
+		}
+		for name, def := range b.defs {
+			defs[name] = def
+		}
+		bundledObject["$defs"] = defs
+	}
+
+	if err := detectRefCycles(bundled); err != nil {
+		return "", err
+	}
+
+	content, err := json.MarshalIndent(bundled, "", "  ")
+	if err != nil {
+		return "", errors.New("failed to encode bundled schema: " + err.Error())
+	}
+
+	return string(content), nil
+}
+
+// sources returns the root schema followed by every referenced file in alphabetical order
+func (b *bundler) sources() []string {
+	referenced := make([]string, 0, len(b.defNames))
+	for filePath := range b.defNames {
+		referenced = append(referenced, filePath)
+	}
+	sort.Strings(referenced)
+	return append([]string{b.rootPath}, referenced...)
+}
+
+// rewrite walks a schema loaded from filePath and rewrites its references. defName is
+// the name of the definition the schema is inlined as, empty for the root schema.
+func (b *bundler) rewrite(node interface{}, filePath string, defName string) (interface{}, error) {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for _, key := range sortedKeys(value) {
+			if key == "$ref" {
+				if ref, ok := value[key].(string); ok {
+					rewritten, err := b.rewriteRef(ref, filePath, defName)
+					if err != nil {
+						return nil, err
+					}
+					result[key] = rewritten
+					continue
+				}
+			}
+
+			rewritten, err := b.rewrite(value[key], filePath, defName)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = rewritten
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, item := range value {
+			rewritten, err := b.rewrite(item, filePath, defName)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = rewritten
+		}
+		return result, nil
+	default:
+		return node, nil
+	}
+}
+
+func (b *bundler) rewriteRef(ref string, filePath string, defName string) (string, error) {
+	location, fragment, _ := strings.Cut(ref, "#")
+
+	// References within the same document
+	if location == "" {
+		return pointerTo(defName, fragment), nil
+	}
+
+	// Remote references are kept as they are
+	if strings.Contains(location, "://") && !strings.HasPrefix(location, "file://") {
+		return ref, nil
+	}
+
+	targetPath := strings.TrimPrefix(location, "file://")
+	if !filepath.IsAbs(targetPath) {
+		targetPath = filepath.Join(filepath.Dir(filePath), targetPath)
+	}
+	targetPath = filepath.Clean(targetPath)
+
+	targetDefName, err := b.include(targetPath)
+	if err != nil {
+		return "", err
+	}
+
+	return pointerTo(targetDefName, fragment), nil
+}
+
+// include inlines a referenced file and returns the name of its definition
+func (b *bundler) include(filePath string) (string, error) {
+	if filePath == b.rootPath {
+		return "", nil
+	}
+	if name, exists := b.defNames[filePath]; exists {
+		return name, nil
+	}
+
+	name := b.uniqueDefName(filePath)
+	// Register the name before walking the file, so references back to it resolve to the definition
+	b.defNames[filePath] = name
+
+	schema, err := loadJSONFile(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	// Nested $id and $schema would change how the inlined definition is resolved
+	if object, ok := schema.(map[string]interface{}); ok {
+		delete(object, "$id")
+		delete(object, "$schema")
+	}
+
+	rewritten, err := b.rewrite(schema, filePath, name)
+	if err != nil {
+		return "", err
+	}
+	b.defs[name] = rewritten
+
+	return name, nil
+}
+
+func (b *bundler) uniqueDefName(filePath string) string {
+	base := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	base = strings.Trim(defNameSanitizer.ReplaceAllString(base, "_"), "_")
+	if base == "" {
+		base = "schema"
+	}
+
+	name := base
+	for i := 2; b.taken[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	b.taken[name] = true
+
+	return name
+}
+
+// pointerTo builds a reference to a fragment of an inlined definition or of the root schema
+func pointerTo(defName string, fragment string) string {
+	if defName == "" {
+		return "#" + fragment
+	}
+	if fragment != "" && !strings.HasPrefix(fragment, "/") {
+		// Anchors are kept relative to the root document
+		return "#" + fragment
+	}
+	return "#/$defs/" + defName + fragment
+}
+
+func loadJSONFile(filePath string) (interface{}, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %s", err)
+	}
+
+	var schema interface{}
+	if err := json.Unmarshal(content, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema content in %s: %s", filePath, err)
+	}
+
+	return schema, nil
+}
+
+// detectRefCycles reports definitions which consist only of references leading back to themselves
+func detectRefCycles(root interface{}) error {
+	rootObject, ok := root.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	defs, _ := rootObject["$defs"].(map[string]interface{})
+
+	names := make([]string, 0, len(defs)+1)
+	names = append(names, "")
+	for name := range defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, start := range names {
+		visited := map[string]bool{}
+		current := "#"
+		if start != "" {
+			current = "#/$defs/" + start
+		}
+		for {
+			if visited[current] {
+				return fmt.Errorf("circular $ref chain detected at %s", current)
+			}
+			visited[current] = true
+
+			ref, ok := pureReference(resolvePointer(rootObject, current))
+			if !ok || !strings.HasPrefix(ref, "#") {
+				break
+			}
+			current = ref
+		}
+	}
+
+	return nil
+}
+
+// pureReference returns the reference of a schema which consists of nothing but the reference
+// and keywords without effect on validation
+func pureReference(node interface{}) (string, bool) {
+	object, ok := node.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	ref, ok := object["$ref"].(string)
+	if !ok {
+		return "", false
+	}
+	for key := range object {
+		switch key {
+		case "$ref", "$defs", "$schema", "$id", "$comment", "title", "description":
+		default:
+			return "", false
+		}
+	}
+	return ref, true
+}
+
+// resolvePointer resolves a local JSON pointer reference like #/$defs/name
+func resolvePointer(root interface{}, ref string) interface{} {
+	pointer := strings.TrimPrefix(ref, "#")
+	if pointer == "" {
+		return root
+	}
+
+	node := root
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch value := node.(type) {
+		case map[string]interface{}:
+			node = value[token]
+		case []interface{}:
+			index := -1
+			fmt.Sscanf(token, "%d", &index)
+			if index < 0 || index >= len(value) {
+				return nil
+			}
+			node = value[index]
+		default:
+			return nil
+		}
+	}
+	return node
+}
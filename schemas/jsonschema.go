@@ -0,0 +1,209 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// jsonSchemaURL is the base URL under which schemas are compiled
+const jsonSchemaURL = "paw:///schema.json"
+
+// normalizeJSONSchema checks that the content is a valid JSON Schema and returns it compacted
+func normalizeJSONSchema(content string) (string, error) {
+	var schemaJSON interface{}
+	if err := json.Unmarshal([]byte(content), &schemaJSON); err != nil {
+		return "", errors.New("invalid schema content: " + err.Error())
+	}
+
+	if _, err := compileJSONSchema(content); err != nil {
+		return "", errors.New("invalid JSON schema: " + err.Error())
+	}
+
+	// Re-marshal the schema to ensure it's properly escaped
+	escapedSchema, err := json.Marshal(schemaJSON)
+	if err != nil {
+		return "", errors.New("failed to escape schema content: " + err.Error())
+	}
+
+	return string(escapedSchema), nil
+}
+
+// compileJSONSchema compiles the schema against its meta-schema. External references are
+// not loaded, schemas split across several files have to be bundled first. Formats are
+// asserted for every draft.
+func compileJSONSchema(content string) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat = true
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("external reference %s is not supported", url)
+	}
+	if err := compiler.AddResource(jsonSchemaURL, strings.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	return compiler.Compile(jsonSchemaURL)
+}
+
+// checkJSONSchemaCompatibility reports changes which make documents valid against one
+// version of the schema invalid against the other one
+func checkJSONSchemaCompatibility(previous string, next string) ([]Incompatibility, error) {
+	var previousSchema, nextSchema map[string]interface{}
+	if err := json.Unmarshal([]byte(previous), &previousSchema); err != nil {
+		return nil, errors.New("invalid previous schema: " + err.Error())
+	}
+	if err := json.Unmarshal([]byte(next), &nextSchema); err != nil {
+		return nil, errors.New("invalid new schema: " + err.Error())
+	}
+
+	var issues []Incompatibility
+	compareJSONSchemas("#", previousSchema, nextSchema, &issues)
+	return issues, nil
+}
+
+func compareJSONSchemas(path string, previous map[string]interface{}, next map[string]interface{}, issues *[]Incompatibility) {
+	previousTypes := jsonSchemaTypes(previous)
+	nextTypes := jsonSchemaTypes(next)
+	if len(previousTypes) > 0 && len(nextTypes) > 0 {
+		for _, previousType := range previousTypes {
+			if !containsJSONSchemaType(nextTypes, previousType) {
+				*issues = append(*issues, Incompatibility{
+					Path:    path,
+					Message: fmt.Sprintf("type changed from %s to %s", strings.Join(previousTypes, "|"), strings.Join(nextTypes, "|")),
+				})
+				return
+			}
+		}
+	}
+
+	// Enum values which are no longer allowed break producers of the previous version
+	if previousEnum, ok := previous["enum"].([]interface{}); ok {
+		nextEnum, _ := next["enum"].([]interface{})
+		if nextEnum != nil {
+			for _, value := range previousEnum {
+				if !containsJSONValue(nextEnum, value) {
+					*issues = append(*issues, Incompatibility{
+						Path:    path,
+						Message: fmt.Sprintf("enum value %v was removed", value),
+					})
+				}
+			}
+		}
+	} else if _, ok := next["enum"]; ok {
+		*issues = append(*issues, Incompatibility{
+			Path:    path,
+			Message: "enum restriction was added",
+		})
+	}
+
+	previousRequired := jsonSchemaRequired(previous)
+	nextRequired := jsonSchemaRequired(next)
+	for _, name := range sortedKeys(nextRequired) {
+		if !previousRequired[name] {
+			*issues = append(*issues, Incompatibility{
+				Path:    path + "/required",
+				Message: fmt.Sprintf("property %q became required", name),
+			})
+		}
+	}
+
+	previousProperties, _ := previous["properties"].(map[string]interface{})
+	nextProperties, _ := next["properties"].(map[string]interface{})
+	nextAllowsAdditional := next["additionalProperties"] != false
+
+	for _, name := range sortedKeys(previousProperties) {
+		propertyPath := path + "/properties/" + name
+		nextProperty, exists := nextProperties[name]
+		if !exists {
+			if previousRequired[name] {
+				*issues = append(*issues, Incompatibility{
+					Path:    propertyPath,
+					Message: fmt.Sprintf("required property %q was removed", name),
+				})
+			} else if !nextAllowsAdditional {
+				*issues = append(*issues, Incompatibility{
+					Path:    propertyPath,
+					Message: fmt.Sprintf("property %q was removed while additional properties are not allowed", name),
+				})
+			}
+			continue
+		}
+
+		previousPropertySchema, previousOk := previousProperties[name].(map[string]interface{})
+		nextPropertySchema, nextOk := nextProperty.(map[string]interface{})
+		if previousOk && nextOk {
+			compareJSONSchemas(propertyPath, previousPropertySchema, nextPropertySchema, issues)
+		}
+	}
+
+	previousItems, previousOk := previous["items"].(map[string]interface{})
+	nextItems, nextOk := next["items"].(map[string]interface{})
+	if previousOk && nextOk {
+		compareJSONSchemas(path+"/items", previousItems, nextItems, issues)
+	}
+}
+
+func jsonSchemaTypes(schema map[string]interface{}) []string {
+	switch value := schema["type"].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		types := make([]string, 0, len(value))
+		for _, item := range value {
+			if name, ok := item.(string); ok {
+				types = append(types, name)
+			}
+		}
+		return types
+	default:
+		return nil
+	}
+}
+
+// containsJSONSchemaType checks if a type is accepted by a list of types. Integers
+// are always accepted where numbers are allowed.
+func containsJSONSchemaType(types []string, schemaType string) bool {
+	for _, candidate := range types {
+		if candidate == schemaType || (candidate == "number" && schemaType == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonSchemaRequired(schema map[string]interface{}) map[string]bool {
+	required := map[string]bool{}
+	if names, ok := schema["required"].([]interface{}); ok {
+		for _, name := range names {
+			if value, ok := name.(string); ok {
+				required[value] = true
+			}
+		}
+	}
+	return required
+}
+
+func containsJSONValue(values []interface{}, value interface{}) bool {
+	encoded, _ := json.Marshal(value)
+	for _, candidate := range values {
+		candidateEncoded, _ := json.Marshal(candidate)
+		if string(candidateEncoded) == string(encoded) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
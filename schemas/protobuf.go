@@ -0,0 +1,183 @@
+package schemas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const protobufFileName = "schema.proto"
+
+// normalizeProtobuf checks that the content is a valid .proto file. Protobuf schemas are
+// uploaded as they are.
+func normalizeProtobuf(content string) (string, error) {
+	if _, err := compileProtobuf(content); err != nil {
+		return "", errors.New("invalid Protobuf schema: " + err.Error())
+	}
+
+	return content, nil
+}
+
+// compileProtobuf parses the .proto file into a descriptor. Only well-known types
+// can be imported from the schema.
+func compileProtobuf(content string) (protoreflect.FileDescriptor, error) {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{
+				protobufFileName: content,
+			}),
+		}),
+	}
+
+	files, err := compiler.Compile(context.Background(), protobufFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return files[0], nil
+}
+
+// checkProtobufCompatibility reports changes which break the wire or JSON compatibility
+// of messages defined in the previous version of the schema
+func checkProtobufCompatibility(previous string, next string) ([]Incompatibility, error) {
+	previousFile, err := compileProtobuf(previous)
+	if err != nil {
+		return nil, errors.New("invalid previous schema: " + err.Error())
+	}
+	nextFile, err := compileProtobuf(next)
+	if err != nil {
+		return nil, errors.New("invalid new schema: " + err.Error())
+	}
+
+	nextMessages := map[protoreflect.FullName]protoreflect.MessageDescriptor{}
+	collectProtobufMessages(nextFile.Messages(), nextMessages)
+	previousMessages := map[protoreflect.FullName]protoreflect.MessageDescriptor{}
+	collectProtobufMessages(previousFile.Messages(), previousMessages)
+
+	var issues []Incompatibility
+	for _, name := range sortedProtobufNames(previousMessages) {
+		nextMessage, exists := nextMessages[name]
+		if !exists {
+			issues = append(issues, Incompatibility{
+				Path:    string(name),
+				Message: "message was removed",
+			})
+			continue
+		}
+		compareProtobufMessages(previousMessages[name], nextMessage, &issues)
+	}
+
+	nextEnums := map[protoreflect.FullName]protoreflect.EnumDescriptor{}
+	collectProtobufEnums(nextFile.Enums(), nextFile.Messages(), nextEnums)
+	previousEnums := map[protoreflect.FullName]protoreflect.EnumDescriptor{}
+	collectProtobufEnums(previousFile.Enums(), previousFile.Messages(), previousEnums)
+
+	for _, name := range sortedProtobufNames(previousEnums) {
+		nextEnum, exists := nextEnums[name]
+		if !exists {
+			issues = append(issues, Incompatibility{
+				Path:    string(name),
+				Message: "enum was removed",
+			})
+			continue
+		}
+		previousValues := previousEnums[name].Values()
+		for i := 0; i < previousValues.Len(); i++ {
+			value := previousValues.Get(i)
+			if nextEnum.Values().ByNumber(value.Number()) == nil {
+				issues = append(issues, Incompatibility{
+					Path:    string(value.FullName()),
+					Message: fmt.Sprintf("enum value %d was removed", value.Number()),
+				})
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+func compareProtobufMessages(previous protoreflect.MessageDescriptor, next protoreflect.MessageDescriptor, issues *[]Incompatibility) {
+	previousFields := previous.Fields()
+	for i := 0; i < previousFields.Len(); i++ {
+		previousField := previousFields.Get(i)
+		path := fmt.Sprintf("%s.%s", previous.FullName(), previousField.Name())
+
+		nextField := next.Fields().ByNumber(previousField.Number())
+		if nextField == nil {
+			if !next.ReservedRanges().Has(previousField.Number()) {
+				*issues = append(*issues, Incompatibility{
+					Path:    path,
+					Message: fmt.Sprintf("field %d was removed without reserving its number", previousField.Number()),
+				})
+			}
+			continue
+		}
+
+		if nextField.Kind() != previousField.Kind() {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("field %d type changed from %s to %s", previousField.Number(), previousField.Kind(), nextField.Kind()),
+			})
+			continue
+		}
+		if nextField.Cardinality() != previousField.Cardinality() {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("field %d cardinality changed from %s to %s", previousField.Number(), previousField.Cardinality(), nextField.Cardinality()),
+			})
+		}
+		if previousField.Message() != nil && nextField.Message() != nil && previousField.Message().FullName() != nextField.Message().FullName() {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("field %d message type changed from %s to %s", previousField.Number(), previousField.Message().FullName(), nextField.Message().FullName()),
+			})
+		}
+		if previousField.Enum() != nil && nextField.Enum() != nil && previousField.Enum().FullName() != nextField.Enum().FullName() {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("field %d enum type changed from %s to %s", previousField.Number(), previousField.Enum().FullName(), nextField.Enum().FullName()),
+			})
+		}
+		if nextField.Name() != previousField.Name() {
+			*issues = append(*issues, Incompatibility{
+				Path:    path,
+				Message: fmt.Sprintf("field %d was renamed to %s which breaks JSON encoding", previousField.Number(), nextField.Name()),
+			})
+		}
+	}
+}
+
+func collectProtobufMessages(messages protoreflect.MessageDescriptors, result map[protoreflect.FullName]protoreflect.MessageDescriptor) {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		result[message.FullName()] = message
+		collectProtobufMessages(message.Messages(), result)
+	}
+}
+
+func collectProtobufEnums(enums protoreflect.EnumDescriptors, messages protoreflect.MessageDescriptors, result map[protoreflect.FullName]protoreflect.EnumDescriptor) {
+	for i := 0; i < enums.Len(); i++ {
+		result[enums.Get(i).FullName()] = enums.Get(i)
+	}
+	for i := 0; i < messages.Len(); i++ {
+		collectProtobufEnums(messages.Get(i).Enums(), messages.Get(i).Messages(), result)
+	}
+}
+
+func sortedProtobufNames[V any](values map[protoreflect.FullName]V) []protoreflect.FullName {
+	names := make([]protoreflect.FullName, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
@@ -0,0 +1,549 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp/syntax"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const (
+	// sampleMaxDepth limits how deep recursive schemas are expanded
+	sampleMaxDepth = 8
+	// sampleMaxAttempts is the number of tries to generate a document valid against the schema
+	sampleMaxAttempts = 20
+)
+
+// Sampler generates random documents which are valid against a JSON Schema. Values
+// from `const`, `enum` and `examples` keywords are preferred when they are present.
+type Sampler struct {
+	root     interface{}
+	compiled *jsonschema.Schema
+	random   *rand.Rand
+}
+
+// NewSampler prepares a sampler for the schema. Samplers created with the same seed
+// generate the same sequence of documents.
+func NewSampler(schemaContent string, seed int64) (*Sampler, error) {
+	var root interface{}
+	if err := json.Unmarshal([]byte(schemaContent), &root); err != nil {
+		return nil, errors.New("invalid schema content: " + err.Error())
+	}
+
+	compiled, err := compileJSONSchema(schemaContent)
+	if err != nil {
+		return nil, errors.New("invalid JSON schema: " + err.Error())
+	}
+
+	return &Sampler{
+		root:     root,
+		compiled: compiled,
+		random:   rand.New(rand.NewSource(seed)),
+	}, nil
+}
+
+// Generate produces the next sample document
+func (s *Sampler) Generate() (interface{}, error) {
+	var lastErr error
+	for attempt := 0; attempt < sampleMaxAttempts; attempt++ {
+		document, err := s.generate(s.root, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if lastErr = s.compiled.Validate(document); lastErr == nil {
+			return document, nil
+		}
+	}
+
+	return nil, errors.New("failed to generate a document valid against the schema: " + lastErr.Error())
+}
+
+func (s *Sampler) generate(node interface{}, depth int) (interface{}, error) {
+	switch value := node.(type) {
+	case bool:
+		if !value {
+			return nil, errors.New("schema does not allow any value")
+		}
+		return s.randomWord(3, 8), nil
+	case map[string]interface{}:
+		return s.generateFromObject(value, depth)
+	default:
+		return nil, fmt.Errorf("unsupported schema node %v", node)
+	}
+}
+
+func (s *Sampler) generateFromObject(schema map[string]interface{}, depth int) (interface{}, error) {
+	// Deeply nested documents end with a value which needs no further nesting, so that
+	// recursive schemas are not expanded forever
+	if depth >= sampleMaxDepth {
+		if value, ok := s.terminalValue(schema); ok {
+			return value, nil
+		}
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		if depth >= sampleMaxDepth {
+			return nil, fmt.Errorf("reference %s is nested more than %d levels deep and the schema allows no value to end it, such as null, a const or an example", ref, sampleMaxDepth)
+		}
+		if !strings.HasPrefix(ref, "#") {
+			return nil, fmt.Errorf("external reference %s is not supported, bundle the schema first", ref)
+		}
+		target := resolvePointer(s.root, ref)
+		if target == nil {
+			return nil, fmt.Errorf("reference %s cannot be resolved", ref)
+		}
+		return s.generate(target, depth+1)
+	}
+
+	if value, ok := schema["const"]; ok {
+		return value, nil
+	}
+	if values, ok := schema["enum"].([]interface{}); ok && len(values) > 0 {
+		return values[s.random.Intn(len(values))], nil
+	}
+	if values, ok := schema["examples"].([]interface{}); ok && len(values) > 0 {
+		return values[s.random.Intn(len(values))], nil
+	}
+
+	if subschemas, ok := schema["allOf"].([]interface{}); ok && len(subschemas) > 0 {
+		merged := mergeSchemas(schema, subschemas, s.root)
+		delete(merged, "allOf")
+		return s.generateFromObject(merged, depth)
+	}
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if subschemas, ok := schema[keyword].([]interface{}); ok && len(subschemas) > 0 {
+			chosen := subschemas[s.random.Intn(len(subschemas))]
+			merged := mergeSchemas(schema, []interface{}{chosen}, s.root)
+			delete(merged, keyword)
+			return s.generateFromObject(merged, depth)
+		}
+	}
+
+	switch s.chooseType(schema) {
+	case "object":
+		return s.generateObject(schema, depth)
+	case "array":
+		return s.generateArray(schema, depth)
+	case "string":
+		return s.generateString(schema)
+	case "integer":
+		return s.generateInteger(schema), nil
+	case "number":
+		return s.generateNumber(schema), nil
+	case "boolean":
+		return s.random.Intn(2) == 0, nil
+	default:
+		return nil, nil
+	}
+}
+
+// terminalValue picks a value for the schema which needs no further nesting: its const,
+// one of its enum values or examples, or null when the schema allows it
+func (s *Sampler) terminalValue(schema map[string]interface{}) (interface{}, bool) {
+	if value, ok := schema["const"]; ok {
+		return value, true
+	}
+	for _, keyword := range []string{"enum", "examples"} {
+		if values, ok := schema[keyword].([]interface{}); ok && len(values) > 0 {
+			return values[s.random.Intn(len(values))], true
+		}
+	}
+	for _, schemaType := range jsonSchemaTypes(schema) {
+		if schemaType == "null" {
+			return nil, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Sampler) chooseType(schema map[string]interface{}) string {
+	types := jsonSchemaTypes(schema)
+	if len(types) > 0 {
+		// Prefer actual values over null when a choice is given, deeply nested documents end with null
+		var candidates []string
+		for _, schemaType := range types {
+			if schemaType != "null" {
+				candidates = append(candidates, schemaType)
+			}
+		}
+		if len(candidates) == 0 {
+			return "null"
+		}
+		return candidates[s.random.Intn(len(candidates))]
+	}
+
+	switch {
+	case schema["properties"] != nil || schema["required"] != nil:
+		return "object"
+	case schema["items"] != nil || schema["prefixItems"] != nil:
+		return "array"
+	case schema["minimum"] != nil || schema["maximum"] != nil:
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+func (s *Sampler) generateObject(schema map[string]interface{}, depth int) (interface{}, error) {
+	result := map[string]interface{}{}
+	properties, _ := schema["properties"].(map[string]interface{})
+	required := jsonSchemaRequired(schema)
+
+	for _, name := range sortedKeys(properties) {
+		// Optional properties are included randomly, and skipped altogether in deeply nested documents
+		if !required[name] && (depth >= sampleMaxDepth || s.random.Intn(2) == 0) {
+			continue
+		}
+		value, err := s.generate(properties[name], depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+		result[name] = value
+	}
+
+	// Required properties without a definition accept any value
+	for _, name := range sortedKeys(required) {
+		if _, exists := result[name]; !exists {
+			result[name] = s.randomWord(3, 8)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Sampler) generateArray(schema map[string]interface{}, depth int) (interface{}, error) {
+	minItems := intKeyword(schema, "minItems", 0)
+	maxItems := intKeyword(schema, "maxItems", minItems+3)
+	if depth >= sampleMaxDepth {
+		maxItems = minItems
+	}
+	if minItems == 0 && maxItems > 0 && depth < sampleMaxDepth {
+		minItems = 1
+	}
+
+	count := minItems
+	if maxItems > minItems {
+		count += s.random.Intn(maxItems - minItems + 1)
+	}
+
+	prefixItems, _ := schema["prefixItems"].([]interface{})
+	items := schema["items"]
+	unique, _ := schema["uniqueItems"].(bool)
+
+	result := make([]interface{}, 0, count)
+	seen := map[string]bool{}
+	for i := 0; len(result) < count && i < count*10; i++ {
+		var itemSchema interface{} = true
+		switch {
+		case len(result) < len(prefixItems):
+			itemSchema = prefixItems[len(result)]
+		case items != nil:
+			itemSchema = items
+		}
+
+		value, err := s.generate(itemSchema, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if unique {
+			key, _ := json.Marshal(value)
+			if seen[string(key)] {
+				continue
+			}
+			seen[string(key)] = true
+		}
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+func (s *Sampler) generateString(schema map[string]interface{}) (interface{}, error) {
+	var value string
+	format, _ := schema["format"].(string)
+	pattern, _ := schema["pattern"].(string)
+
+	switch {
+	case format != "":
+		value = s.formattedString(format)
+	case pattern != "":
+		generated, err := s.stringFromPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		return generated, nil
+	default:
+		value = s.randomWord(5, 12)
+	}
+
+	minLength := intKeyword(schema, "minLength", 0)
+	maxLength := intKeyword(schema, "maxLength", -1)
+	for len(value) < minLength {
+		value += s.randomWord(1, minLength-len(value))
+	}
+	if maxLength >= 0 && len(value) > maxLength {
+		value = value[:maxLength]
+	}
+
+	return value, nil
+}
+
+func (s *Sampler) formattedString(format string) string {
+	switch format {
+	case "email", "idn-email":
+		return fmt.Sprintf("%s@%s.com", s.randomWord(4, 10), s.randomWord(4, 8))
+	case "date-time":
+		return s.randomTime().Format(time.RFC3339)
+	case "date":
+		return s.randomTime().Format("2006-01-02")
+	case "time":
+		return s.randomTime().Format("15:04:05Z")
+	case "uuid":
+		bytes := make([]byte, 16)
+		s.random.Read(bytes)
+		bytes[6] = (bytes[6] & 0x0f) | 0x40
+		bytes[8] = (bytes[8] & 0x3f) | 0x80
+		return fmt.Sprintf("%x-%x-%x-%x-%x", bytes[0:4], bytes[4:6], bytes[6:8], bytes[8:10], bytes[10:])
+	case "uri", "url", "iri", "uri-reference", "iri-reference":
+		return fmt.Sprintf("https://%s.com/%s", s.randomWord(4, 8), s.randomWord(3, 8))
+	case "hostname", "idn-hostname":
+		return fmt.Sprintf("%s.%s.com", s.randomWord(3, 8), s.randomWord(3, 8))
+	case "ipv4":
+		return fmt.Sprintf("%d.%d.%d.%d", 1+s.random.Intn(254), s.random.Intn(256), s.random.Intn(256), 1+s.random.Intn(254))
+	case "ipv6":
+		parts := make([]string, 8)
+		for i := range parts {
+			parts[i] = fmt.Sprintf("%x", s.random.Intn(65536))
+		}
+		return strings.Join(parts, ":")
+	default:
+		return s.randomWord(5, 12)
+	}
+}
+
+func (s *Sampler) generateInteger(schema map[string]interface{}) interface{} {
+	minimum, maximum := numericBounds(schema, true)
+	multipleOf, _ := schema["multipleOf"].(float64)
+
+	low := math.Ceil(minimum)
+	high := math.Floor(maximum)
+	if high < low {
+		high = low
+	}
+
+	step := 1.0
+	if multipleOf >= 1 {
+		step = math.Trunc(multipleOf)
+	}
+	first := math.Ceil(low/step) * step
+	value := first + s.randomSteps((high-first)/step)*step
+
+	// Integers beyond the int64 range are still valid JSON numbers
+	if value >= math.MinInt64 && value < math.MaxInt64 {
+		return int64(value)
+	}
+	return value
+}
+
+func (s *Sampler) generateNumber(schema map[string]interface{}) interface{} {
+	minimum, maximum := numericBounds(schema, false)
+	if multipleOf, ok := schema["multipleOf"].(float64); ok && multipleOf > 0 {
+		first := math.Ceil(minimum/multipleOf) * multipleOf
+		return first + s.randomSteps((maximum-first)/multipleOf)*multipleOf
+	}
+
+	// Interpolate instead of adding the range to the minimum, which can overflow
+	ratio := s.random.Float64()
+	value := minimum*(1-ratio) + maximum*ratio
+	if math.Abs(value) < 1e15 {
+		value = math.Round(value*100) / 100
+	}
+	return math.Max(minimum, math.Min(maximum, value))
+}
+
+// randomSteps picks a whole number of steps between 0 and steps. Ranges too large
+// for int64 are sampled in float64.
+func (s *Sampler) randomSteps(steps float64) float64 {
+	steps = math.Floor(steps)
+	if math.IsNaN(steps) || steps <= 0 {
+		return 0
+	}
+	if steps < 1<<62 {
+		return float64(s.random.Int63n(int64(steps) + 1))
+	}
+	return math.Floor(s.random.Float64() * math.Min(steps, math.MaxFloat64))
+}
+
+// numericBounds computes the inclusive range of a numeric schema. Both the boolean
+// (draft-04) and the numeric forms of exclusive bounds are supported.
+func numericBounds(schema map[string]interface{}, integer bool) (float64, float64) {
+	step := 0.01
+	if integer {
+		step = 1
+	}
+
+	minimum, hasMinimum := schema["minimum"].(float64)
+	maximum, hasMaximum := schema["maximum"].(float64)
+	if exclusive, ok := schema["exclusiveMinimum"].(float64); ok {
+		minimum, hasMinimum = exclusive+step, true
+	} else if exclusive, ok := schema["exclusiveMinimum"].(bool); ok && exclusive && hasMinimum {
+		minimum += step
+	}
+	if exclusive, ok := schema["exclusiveMaximum"].(float64); ok {
+		maximum, hasMaximum = exclusive-step, true
+	} else if exclusive, ok := schema["exclusiveMaximum"].(bool); ok && exclusive && hasMaximum {
+		maximum -= step
+	}
+
+	switch {
+	case !hasMinimum && !hasMaximum:
+		minimum, maximum = 0, 1000
+	case !hasMinimum:
+		minimum = maximum - 1000
+	case !hasMaximum:
+		maximum = minimum + 1000
+	}
+	return minimum, maximum
+}
+
+// stringFromPattern generates a string matching a regular expression
+func (s *Sampler) stringFromPattern(pattern string) (string, error) {
+	expression, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("invalid pattern %q: %s", pattern, err)
+	}
+
+	var builder strings.Builder
+	s.writeRegexp(&builder, expression.Simplify())
+	return builder.String(), nil
+}
+
+func (s *Sampler) writeRegexp(builder *strings.Builder, expression *syntax.Regexp) {
+	switch expression.Op {
+	case syntax.OpLiteral:
+		builder.WriteString(string(expression.Rune))
+	case syntax.OpCharClass:
+		if len(expression.Rune) < 2 {
+			return
+		}
+		pair := s.random.Intn(len(expression.Rune) / 2)
+		low, high := expression.Rune[pair*2], expression.Rune[pair*2+1]
+		// Keep generated characters printable
+		if high > 0x7e && low <= 0x7e {
+			high = 0x7e
+		}
+		if low < 0x20 && high >= 0x20 {
+			low = 0x20
+		}
+		builder.WriteRune(low + rune(s.random.Intn(int(high-low)+1)))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		builder.WriteByte(byte('a' + s.random.Intn(26)))
+	case syntax.OpCapture:
+		for _, sub := range expression.Sub {
+			s.writeRegexp(builder, sub)
+		}
+	case syntax.OpConcat:
+		for _, sub := range expression.Sub {
+			s.writeRegexp(builder, sub)
+		}
+	case syntax.OpAlternate:
+		s.writeRegexp(builder, expression.Sub[s.random.Intn(len(expression.Sub))])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		minimum, maximum := expression.Min, expression.Max
+		switch expression.Op {
+		case syntax.OpStar:
+			minimum, maximum = 0, 3
+		case syntax.OpPlus:
+			minimum, maximum = 1, 4
+		case syntax.OpQuest:
+			minimum, maximum = 0, 1
+		}
+		if maximum < 0 {
+			maximum = minimum + 3
+		}
+		count := minimum + s.random.Intn(maximum-minimum+1)
+		for i := 0; i < count; i++ {
+			s.writeRegexp(builder, expression.Sub[0])
+		}
+	}
+}
+
+func (s *Sampler) randomWord(minLength int, maxLength int) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	length := minLength
+	if maxLength > minLength {
+		length += s.random.Intn(maxLength - minLength + 1)
+	}
+
+	word := make([]byte, length)
+	for i := range word {
+		word[i] = letters[s.random.Intn(len(letters))]
+	}
+	return string(word)
+}
+
+func (s *Sampler) randomTime() time.Time {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	return start.Add(time.Duration(s.random.Int63n(int64(5 * 365 * 24 * time.Hour)))).Truncate(time.Second)
+}
+
+// mergeSchemas combines a schema with its subschemas, merging their properties and required fields
+func mergeSchemas(schema map[string]interface{}, subschemas []interface{}, root interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for key, value := range schema {
+		merged[key] = value
+	}
+
+	for _, subschema := range subschemas {
+		object, ok := subschema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ref, ok := object["$ref"].(string); ok && strings.HasPrefix(ref, "#") {
+			if target, ok := resolvePointer(root, ref).(map[string]interface{}); ok {
+				object = target
+			}
+		}
+
+		for key, value := range object {
+			switch key {
+			case "properties":
+				properties := map[string]interface{}{}
+				if existing, ok := merged["properties"].(map[string]interface{}); ok {
+					for name, property := range existing {
+						properties[name] = property
+					}
+				}
+				if added, ok := value.(map[string]interface{}); ok {
+					for name, property := range added {
+						properties[name] = property
+					}
+				}
+				merged["properties"] = properties
+			case "required":
+				existing, _ := merged["required"].([]interface{})
+				added, _ := value.([]interface{})
+				merged["required"] = append(append([]interface{}{}, existing...), added...)
+			default:
+				merged[key] = value
+			}
+		}
+	}
+
+	return merged
+}
+
+func intKeyword(schema map[string]interface{}, keyword string, fallback int) int {
+	if value, ok := schema[keyword].(float64); ok {
+		return int(value)
+	}
+	return fallback
+}
@@ -0,0 +1,64 @@
+package schemas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+)
+
+// Incompatibility describes a single change between two schema versions
+// which breaks existing producers or consumers of the schema
+type Incompatibility struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ParseType converts a user provided schema type into one of the supported schema types
+func ParseType(schemaType string) (contracts.SchemaType, error) {
+	for _, supported := range contracts.SchemaTypes {
+		if contracts.SchemaType(schemaType) == supported {
+			return supported, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported schema type %q. Must be one of: %s", schemaType, supportedTypesList())
+}
+
+// Normalize validates the schema content locally according to its type and returns
+// the content in the form it should be uploaded to the API
+func Normalize(schemaType contracts.SchemaType, content string) (string, error) {
+	switch schemaType {
+	case contracts.SchemaTypeJSONSchema:
+		return normalizeJSONSchema(content)
+	case contracts.SchemaTypeAvro:
+		return normalizeAvro(content)
+	case contracts.SchemaTypeProtobuf:
+		return normalizeProtobuf(content)
+	default:
+		return "", fmt.Errorf("unsupported schema type %q. Must be one of: %s", schemaType, supportedTypesList())
+	}
+}
+
+// CheckCompatibility compares two versions of a schema and returns all changes which
+// break either existing producers or existing consumers of the previous version
+func CheckCompatibility(schemaType contracts.SchemaType, previous string, next string) ([]Incompatibility, error) {
+	switch schemaType {
+	case contracts.SchemaTypeJSONSchema:
+		return checkJSONSchemaCompatibility(previous, next)
+	case contracts.SchemaTypeAvro:
+		return checkAvroCompatibility(previous, next)
+	case contracts.SchemaTypeProtobuf:
+		return checkProtobufCompatibility(previous, next)
+	default:
+		return nil, fmt.Errorf("unsupported schema type %q. Must be one of: %s", schemaType, supportedTypesList())
+	}
+}
+
+func supportedTypesList() string {
+	names := make([]string, 0, len(contracts.SchemaTypes))
+	for _, schemaType := range contracts.SchemaTypes {
+		names = append(names, string(schemaType))
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,149 @@
+package schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/linkedin/goavro/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// DocumentError describes a single problem found in a validated document. Pointer is
+// the JSON pointer to the invalid value, it is empty when the location is not known.
+type DocumentError struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+// Validator checks documents against a schema
+type Validator struct {
+	validate func(document []byte) []DocumentError
+}
+
+// NewValidator prepares a validator for the schema content. JSON documents are checked
+// against JSON Schemas, against the JSON encoding of Avro schemas and against the JSON
+// mapping of a message declared in Protobuf schemas. protoMessage names that message,
+// it may be empty when the Protobuf schema declares a single top-level message.
+func NewValidator(schemaType contracts.SchemaType, content string, protoMessage string) (*Validator, error) {
+	switch schemaType {
+	case contracts.SchemaTypeJSONSchema:
+		compiled, err := compileJSONSchema(content)
+		if err != nil {
+			return nil, errors.New("invalid JSON schema: " + err.Error())
+		}
+		return &Validator{validate: func(document []byte) []DocumentError {
+			return validateJSONSchemaDocument(compiled, document)
+		}}, nil
+	case contracts.SchemaTypeAvro:
+		codec, err := goavro.NewCodec(content)
+		if err != nil {
+			return nil, errors.New("invalid Avro schema: " + err.Error())
+		}
+		return &Validator{validate: func(document []byte) []DocumentError {
+			if _, _, err := codec.NativeFromTextual(document); err != nil {
+				return []DocumentError{{Message: err.Error()}}
+			}
+			return nil
+		}}, nil
+	case contracts.SchemaTypeProtobuf:
+		file, err := compileProtobuf(content)
+		if err != nil {
+			return nil, errors.New("invalid Protobuf schema: " + err.Error())
+		}
+		descriptor, err := protobufMessage(file, protoMessage)
+		if err != nil {
+			return nil, err
+		}
+		return &Validator{validate: func(document []byte) []DocumentError {
+			return validateProtobufDocument(descriptor, document)
+		}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported schema type %q. Must be one of: %s", schemaType, supportedTypesList())
+	}
+}
+
+// protobufMessage finds a top-level message of a Protobuf file by its short or fully
+// qualified name. Without a name the file must declare exactly one message.
+func protobufMessage(file protoreflect.FileDescriptor, name string) (protoreflect.MessageDescriptor, error) {
+	messages := file.Messages()
+	if name == "" {
+		switch messages.Len() {
+		case 0:
+			return nil, errors.New("Protobuf schema does not declare any message")
+		case 1:
+			return messages.Get(0), nil
+		}
+
+		names := make([]string, 0, messages.Len())
+		for i := 0; i < messages.Len(); i++ {
+			names = append(names, string(messages.Get(i).Name()))
+		}
+		return nil, fmt.Errorf("Protobuf schema declares %d messages (%s), the message to validate against must be named", messages.Len(), strings.Join(names, ", "))
+	}
+
+	if prefix := string(file.Package()) + "."; file.Package() != "" {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	descriptor := messages.ByName(protoreflect.Name(name))
+	if descriptor == nil {
+		return nil, fmt.Errorf("Protobuf schema does not declare a top-level message %q", name)
+	}
+	return descriptor, nil
+}
+
+// Validate returns all problems found in a JSON document
+func (v *Validator) Validate(document []byte) []DocumentError {
+	return v.validate(document)
+}
+
+func validateJSONSchemaDocument(compiled *jsonschema.Schema, document []byte) []DocumentError {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return []DocumentError{{Message: "invalid JSON: " + err.Error()}}
+	}
+
+	err := compiled.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []DocumentError{{Message: err.Error()}}
+	}
+
+	var result []DocumentError
+	collectValidationErrors(validationErr, &result)
+	return result
+}
+
+// collectValidationErrors flattens the tree of validation errors into its leaves
+func collectValidationErrors(validationErr *jsonschema.ValidationError, result *[]DocumentError) {
+	if len(validationErr.Causes) == 0 {
+		*result = append(*result, DocumentError{
+			Pointer: validationErr.InstanceLocation,
+			Message: validationErr.Message,
+		})
+		return
+	}
+	for _, cause := range validationErr.Causes {
+		collectValidationErrors(cause, result)
+	}
+}
+
+func validateProtobufDocument(descriptor protoreflect.MessageDescriptor, document []byte) []DocumentError {
+	message := dynamicpb.NewMessage(descriptor)
+	if err := protojson.Unmarshal(document, message); err != nil {
+		return []DocumentError{{Message: strings.TrimPrefix(err.Error(), "proto: ")}}
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/fusioncatalyst/paw/utils"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v3"
 )
 
@@ -195,4 +196,355 @@ func TestAppRelatedActions(t *testing.T) {
 		}
 		assert.True(t, found, "Newly created app should be found in the list")
 	})
+
+	emailsResource := "async+kafka://mainkafka@readwrite/topic/emails"
+
+	appMessageFlags := func(app string, message string, resource string) []cli.Flag {
+		return []cli.Flag{
+			&cli.StringFlag{
+				Name:  "project-id",
+				Value: projectID,
+			},
+			&cli.StringFlag{
+				Name:  "app",
+				Value: app,
+			},
+			&cli.StringFlag{
+				Name:  "message",
+				Value: message,
+			},
+			&cli.StringFlag{
+				Name:  "resource",
+				Value: resource,
+			},
+		}
+	}
+
+	t.Run("Describe imported app", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.DescribeAppAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "app",
+					Value: "backend_server",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var contract struct {
+			Name  string `json:"name"`
+			Sends []struct {
+				Message  string `json:"message"`
+				Schema   string `json:"schema"`
+				Resource string `json:"resource"`
+			} `json:"sends"`
+			Receives []interface{} `json:"receives"`
+		}
+		err = json.Unmarshal([]byte(output), &contract)
+		assert.Nil(t, err)
+		assert.Equal(t, "backend_server", contract.Name)
+		assert.Len(t, contract.Sends, 2, "backend_server should send two messages")
+		assert.Empty(t, contract.Receives, "backend_server should not receive messages")
+		for _, send := range contract.Sends {
+			assert.Equal(t, emailsResource, send.Resource)
+		}
+	})
+
+	impactFlags := func(schemaFile string, breakingOnly bool) []cli.Flag {
+		return []cli.Flag{
+			&cli.StringFlag{
+				Name:  "project-id",
+				Value: projectID,
+			},
+			&cli.StringFlag{
+				Name:  "schema",
+				Value: "email_account_verification",
+			},
+			&cli.StringFlag{
+				Name:  "schema-file",
+				Value: schemaFile,
+			},
+			&cli.BoolFlag{
+				Name:  "breaking-only",
+				Value: breakingOnly,
+			},
+		}
+	}
+
+	type impactReport struct {
+		Schema   string `json:"schema"`
+		Messages []struct {
+			Name              string `json:"name"`
+			Compatible        *bool  `json:"compatible"`
+			Incompatibilities []struct {
+				Path    string `json:"path"`
+				Message string `json:"message"`
+			} `json:"incompatibilities"`
+			Apps []struct {
+				Name      string `json:"name"`
+				Direction string `json:"direction"`
+				Resource  string `json:"resource"`
+			} `json:"apps"`
+		} `json:"messages"`
+		Apps []string `json:"apps"`
+	}
+
+	t.Run("Impact of a schema change", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ImpactAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema",
+					Value: "email_account_verification",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var report impactReport
+		err = json.Unmarshal([]byte(output), &report)
+		assert.Nil(t, err)
+		require.Len(t, report.Messages, 1)
+		assert.Equal(t, "account_verification_message", report.Messages[0].Name)
+		assert.Nil(t, report.Messages[0].Compatible, "Compatibility is only checked with a schema file")
+		assert.Equal(t, []string{"backend_server", "marketing_and_communications"}, report.Apps)
+
+		directions := map[string]string{}
+		for _, app := range report.Messages[0].Apps {
+			directions[app.Name] = app.Direction
+			assert.Equal(t, emailsResource, app.Resource)
+		}
+		assert.Equal(t, map[string]string{"backend_server": "sends", "marketing_and_communications": "receives"}, directions)
+	})
+
+	t.Run("Impact of a breaking schema change", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ImpactAction, context.Background(), &cli.Command{
+			Flags: impactFlags("testfiles/schemas/emailAccountVerificationBreaking.json", true),
+		})
+		assert.Nil(t, err)
+
+		var report impactReport
+		err = json.Unmarshal([]byte(output), &report)
+		assert.Nil(t, err)
+		require.Len(t, report.Messages, 1)
+		require.NotNil(t, report.Messages[0].Compatible)
+		assert.False(t, *report.Messages[0].Compatible)
+		assert.NotEmpty(t, report.Messages[0].Incompatibilities, "Breaking changes should be explained")
+		assert.Len(t, report.Apps, 2)
+	})
+
+	t.Run("Impact of a compatible schema change", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ImpactAction, context.Background(), &cli.Command{
+			Flags: impactFlags("testfiles/schemas/emailAccountVerificationCompatible.json", true),
+		})
+		assert.Nil(t, err)
+
+		var report impactReport
+		err = json.Unmarshal([]byte(output), &report)
+		assert.Nil(t, err)
+		assert.Empty(t, report.Messages, "Compatible changes have no blast radius")
+		assert.Empty(t, report.Apps)
+	})
+
+	t.Run("Impact of a message change", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ImpactAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "message",
+					Value: "password_recovery_message",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var report impactReport
+		err = json.Unmarshal([]byte(output), &report)
+		assert.Nil(t, err)
+		assert.Equal(t, "email_password_recovery", report.Schema)
+		require.Len(t, report.Messages, 1)
+		assert.Len(t, report.Messages[0].Apps, 2)
+	})
+
+	t.Run("Impact with missing or conflicting flags", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			flags         []cli.Flag
+			expectedError string
+		}{
+			{
+				name: "missing project ID",
+				flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "schema",
+						Value: "email_account_verification",
+					},
+				},
+				expectedError: "Project ID is required",
+			},
+			{
+				name: "missing schema and message",
+				flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "project-id",
+						Value: projectID,
+					},
+				},
+				expectedError: "Schema or message name is required",
+			},
+			{
+				name: "schema and message",
+				flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "project-id",
+						Value: projectID,
+					},
+					&cli.StringFlag{
+						Name:  "schema",
+						Value: "email_account_verification",
+					},
+					&cli.StringFlag{
+						Name:  "message",
+						Value: "account_verification_message",
+					},
+				},
+				expectedError: "Only one of --schema and --message flags can be used at a time",
+			},
+			{
+				name:          "breaking only without schema file",
+				flags:         impactFlags("", true),
+				expectedError: "Schema file is required with --breaking-only",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := utils.CaptureOutputInTests(actions.ImpactAction, context.Background(), &cli.Command{
+					Flags: tc.flags,
+				})
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			})
+		}
+	})
+
+	t.Run("Impact of an unknown schema", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImpactAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema",
+					Value: "does_not_exist",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Schema \"does_not_exist\" not found")
+	})
+
+	t.Run("Add a received message to an app", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.AddAppReceiveAction, context.Background(), &cli.Command{
+			Flags: appMessageFlags("TestApp", "account_verification_message", emailsResource),
+		})
+		assert.Nil(t, err)
+
+		var entry api.AppMessageAPIResponse
+		err = json.Unmarshal([]byte(output), &entry)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, entry.ID)
+	})
+
+	t.Run("Add the same received message twice", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.AddAppReceiveAction, context.Background(), &cli.Command{
+			Flags: appMessageFlags("TestApp", "account_verification_message", emailsResource),
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "already receives")
+	})
+
+	t.Run("Add a sent message with invalid references", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			flags         []cli.Flag
+			expectedError string
+		}{
+			{
+				name:          "unknown app",
+				flags:         appMessageFlags("MissingApp", "account_verification_message", emailsResource),
+				expectedError: "App \"MissingApp\" not found",
+			},
+			{
+				name:          "unknown message",
+				flags:         appMessageFlags("TestApp", "missing_message", emailsResource),
+				expectedError: "Message \"missing_message\" not found",
+			},
+			{
+				name:          "unknown resource",
+				flags:         appMessageFlags("TestApp", "account_verification_message", "async+kafka://mainkafka@readwrite/topic/missing"),
+				expectedError: "not found",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := utils.CaptureOutputInTests(actions.AddAppSendAction, context.Background(), &cli.Command{
+					Flags: tc.flags,
+				})
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			})
+		}
+	})
+
+	t.Run("Delete resource still used by apps", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.DeleteResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "resource",
+					Value: emailsResource,
+				},
+				&cli.BoolFlag{
+					Name:  "yes",
+					Value: true,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "still in use")
+		assert.Contains(t, err.Error(), "app backend_server sends account_verification_message via emails")
+		assert.Contains(t, err.Error(), "app TestApp receives account_verification_message via emails")
+	})
+
+	t.Run("Remove a received message from an app", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.RemoveAppReceiveAction, context.Background(), &cli.Command{
+			Flags: appMessageFlags("TestApp", "account_verification_message", emailsResource),
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "no longer receives")
+	})
+
+	t.Run("Remove a message the app does not send", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.RemoveAppSendAction, context.Background(), &cli.Command{
+			Flags: appMessageFlags("TestApp", "account_verification_message", emailsResource),
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not send")
+	})
 }
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+func TestAsyncAPIImport(t *testing.T) {
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("asyncapi_test%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+
+	var projectID string
+
+	t.Run("Convert AsyncAPI 3.0 document", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "project.yaml")
+		output, err := utils.CaptureOutputInTests(actions.ImportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "file", Value: "testfiles/asyncapi/userService3.yaml"},
+				&cli.StringFlag{Name: "out", Value: outputPath},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "protocol \"ws\" is not supported")
+		assert.Contains(t, output, "channel \"userNotifications\": channel parameters are not supported")
+		assert.Contains(t, output, "channel \"userPresence\": none of its servers could be imported")
+		assert.Contains(t, output, "message \"UserSignedUp\": headers are not supported")
+
+		document, err := provision.Load(outputPath)
+		assert.Nil(t, err)
+		assert.Empty(t, provision.Validate(document))
+		require.Len(t, document.Servers, 1)
+		assert.Equal(t, "async+kafka", document.Servers[0].Type)
+		require.Len(t, document.Apps, 1)
+		assert.Equal(t, "user_service", document.Apps[0].Name)
+		require.NotEmpty(t, document.Apps[0].Sends)
+		assert.Equal(t, "async+kafka://production@readwrite/topic/user_signedup", document.Apps[0].Sends[0].Resource)
+		require.NotEmpty(t, document.Schemas)
+		assert.Contains(t, document.Schemas[0].Schema, "\"$ref\": \"#/$defs/Address\"")
+		for _, message := range document.Messages {
+			assert.NotEqual(t, "UserPresence", message.Name, "Messages of skipped channels should not be imported")
+		}
+	})
+
+	t.Run("Convert AsyncAPI 2.x document", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "project.yaml")
+		_, err := utils.CaptureOutputInTests(actions.ImportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "file", Value: "testfiles/asyncapi/orders2.yaml"},
+				&cli.StringFlag{Name: "out", Value: outputPath},
+			},
+		})
+		assert.Nil(t, err)
+
+		document, err := provision.Load(outputPath)
+		assert.Nil(t, err)
+		require.NotEmpty(t, document.Servers)
+		assert.Equal(t, "async+amqp", document.Servers[0].Type)
+		require.NotEmpty(t, document.Apps)
+		assert.Len(t, document.Apps[0].Sends, 1, "subscribe operations are sent by the application")
+		assert.Len(t, document.Apps[0].Receives, 1, "publish operations are received by the application")
+		for _, schema := range document.Schemas {
+			if schema.Name == "OrderPlaced_payload" {
+				assert.Equal(t, "avro", string(schema.Type))
+			}
+		}
+	})
+
+	t.Run("Convert file which is not an AsyncAPI document", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "file", Value: "testfiles/imports/validImport1.yaml"},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not an AsyncAPI document")
+	})
+
+	t.Run("Apply requires project ID", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "file", Value: "testfiles/asyncapi/orders2.yaml"},
+				&cli.BoolFlag{Name: "apply", Value: true},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Project ID is required with --apply")
+	})
+
+	t.Run("Sign up and create project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "email", Value: newUniqueEmail},
+				&cli.StringFlag{Name: "password", Value: testPassword},
+			},
+		})
+		assert.NoError(t, err)
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			os.Setenv("FC_ACCESS_TOKEN", token)
+		}
+
+		output, err = utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "name", Value: fmt.Sprintf("asyncapitestproject%s", currentTimestamp[:10])},
+				&cli.StringFlag{Name: "belongs-to", Value: "user"},
+				&cli.BoolFlag{Name: "private", Value: true},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdProject struct {
+			ID string `json:"id"`
+		}
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		projectID = createdProject.ID
+	})
+
+	t.Run("Apply AsyncAPI document to project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ImportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "file", Value: "testfiles/asyncapi/userService3.yaml"},
+				&cli.BoolFlag{Name: "apply", Value: true},
+				&cli.StringFlag{Name: "project-id", Value: projectID},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "imported into project")
+
+		output, err = utils.CaptureOutputInTests(actions.ExportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "project-id", Value: projectID},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "user_signedup")
+		assert.Contains(t, output, "UserSignedUpPayload")
+		assert.Contains(t, output, "user_service")
+	})
+}
@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func TestBindingManagement(t *testing.T) {
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("binding_test%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+
+	var projectID string
+	var amqpServerID string
+	var kafkaServerID string
+	var bindID string
+
+	output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "email", Value: newUniqueEmail},
+			&cli.StringFlag{Name: "password", Value: testPassword},
+		},
+	})
+	assert.NoError(t, err)
+	token := strings.TrimSpace(string(output))
+	if token != "" {
+		os.Setenv("FC_ACCESS_TOKEN", token)
+	}
+
+	projectName := fmt.Sprintf("bindingtestproject%s", currentTimestamp[:10])
+	output, err = utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "name", Value: projectName},
+			&cli.StringFlag{Name: "belongs-to", Value: "user"},
+			&cli.BoolFlag{Name: "private", Value: true},
+		},
+	})
+	assert.Nil(t, err)
+
+	var createdProject struct {
+		ID string `json:"id"`
+	}
+	err = json.Unmarshal([]byte(output), &createdProject)
+	assert.Nil(t, err)
+	projectID = createdProject.ID
+
+	createServer := func(t *testing.T, name string, serverType string) string {
+		output, err := utils.CaptureOutputInTests(actions.CreateServer, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "project-id", Value: projectID},
+				&cli.StringFlag{Name: "name", Value: name},
+				&cli.StringFlag{Name: "type", Value: serverType},
+				&cli.StringFlag{Name: "description", Value: "Test server for binding testing"},
+			},
+		})
+		assert.Nil(t, err, "Failed to create server")
+
+		var server contracts.ServerResponse
+		err = json.Unmarshal([]byte(output), &server)
+		assert.Nil(t, err, "Failed to parse server response")
+		return server.ID
+	}
+
+	createResource := func(t *testing.T, serverID string, name string, resourceType string, mode string) {
+		_, err := utils.CaptureOutputInTests(actions.CreateResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "server-id", Value: serverID},
+				&cli.StringFlag{Name: "name", Value: name},
+				&cli.StringFlag{Name: "type", Value: resourceType},
+				&cli.StringFlag{Name: "mode", Value: mode},
+			},
+		})
+		assert.Nil(t, err, "Failed to create resource %s", name)
+	}
+
+	t.Run("Create servers and resources for bindings", func(t *testing.T) {
+		amqpServerID = createServer(t, fmt.Sprintf("rabbit%s", currentTimestamp[:10]), "async+amqp")
+		createResource(t, amqpServerID, "events", "exchange", "write")
+		createResource(t, amqpServerID, "billing_events", "queue", "read")
+
+		kafkaServerID = createServer(t, fmt.Sprintf("kafka%s", currentTimestamp[:10]), "async+kafka")
+		createResource(t, kafkaServerID, "events", "topic", "readwrite")
+	})
+
+	t.Run("Create binding", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateBindingAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "server-id", Value: amqpServerID},
+				&cli.StringFlag{Name: "source", Value: "events"},
+				&cli.StringFlag{Name: "destination", Value: "billing_events"},
+				&cli.StringFlag{Name: "routing-key", Value: "billing.#"},
+			},
+		})
+		assert.Nil(t, err, "Failed to create binding")
+
+		var bind contracts.ServerBind
+		err = json.Unmarshal([]byte(output), &bind)
+		assert.Nil(t, err, "Failed to parse binding response")
+		assert.NotEmpty(t, bind.ID)
+		assert.Equal(t, "events", bind.Source)
+		assert.Equal(t, "billing_events", bind.Destination)
+		assert.Equal(t, "billing.#", bind.RoutingKey)
+		bindID = bind.ID
+	})
+
+	t.Run("List bindings", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ListBindingsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "server-id", Value: amqpServerID},
+			},
+		})
+		assert.Nil(t, err, "Failed to list bindings")
+
+		var binds []contracts.ServerBind
+		err = json.Unmarshal([]byte(output), &binds)
+		assert.Nil(t, err, "Failed to parse bindings list")
+		assert.Len(t, binds, 1)
+	})
+
+	t.Run("Create invalid bindings", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			serverID      string
+			source        string
+			destination   string
+			expectedError string
+		}{
+			{
+				name:          "queue as source",
+				serverID:      amqpServerID,
+				source:        "billing_events",
+				destination:   "billing_events",
+				expectedError: "bind source must be an exchange",
+			},
+			{
+				name:          "exchange as destination",
+				serverID:      amqpServerID,
+				source:        "events",
+				destination:   "events",
+				expectedError: "bind destination must be a queue",
+			},
+			{
+				name:          "unknown destination",
+				serverID:      amqpServerID,
+				source:        "events",
+				destination:   "missing_queue",
+				expectedError: "is not a resource of server",
+			},
+			{
+				name:          "kafka server",
+				serverID:      kafkaServerID,
+				source:        "events",
+				destination:   "events",
+				expectedError: "binds are not supported by async+kafka servers",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := utils.CaptureOutputInTests(actions.CreateBindingAction, context.Background(), &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "server-id", Value: tc.serverID},
+						&cli.StringFlag{Name: "source", Value: tc.source},
+						&cli.StringFlag{Name: "destination", Value: tc.destination},
+					},
+				})
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			})
+		}
+	})
+
+	t.Run("Delete binding", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.DeleteBindingAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "server-id", Value: amqpServerID},
+				&cli.StringFlag{Name: "bind-id", Value: bindID},
+			},
+		})
+		assert.Nil(t, err, "Failed to delete binding")
+		assert.Contains(t, output, "deleted")
+	})
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func TestBundleSchemaActions(t *testing.T) {
+	// Load .env file
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+	projectName := "TestProjectForBundledSchemas"
+	var projectID string // To store the ID of the created project
+
+	rootSchemaFilePath := "testfiles/schemas/bundle/order.json"
+	cyclicSchemaFilePath := "testfiles/schemas/bundle/cyclic.json"
+
+	t.Run("Bundle multi-file schema", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.BundleSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: rootSchemaFilePath,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var bundledSchema map[string]interface{}
+		err = json.Unmarshal([]byte(output), &bundledSchema)
+		assert.Nil(t, err)
+
+		defs, ok := bundledSchema["$defs"].(map[string]interface{})
+		assert.True(t, ok, "Bundled schema should contain $defs")
+		assert.Contains(t, defs, "customer")
+		assert.Contains(t, defs, "address")
+		assert.Contains(t, output, "\"$ref\": \"#/$defs/customer\"")
+		assert.Contains(t, output, "\"$ref\": \"#/$defs/address/$defs/countryCode\"")
+		assert.NotContains(t, output, ".json\"")
+	})
+
+	t.Run("Bundle schema with circular references", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.BundleSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: cyclicSchemaFilePath,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "circular $ref chain detected")
+	})
+
+	t.Run("Sign up", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: newUniqueEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			if err := os.Setenv("FC_ACCESS_TOKEN", token); err != nil {
+				t.Fatalf("Failed to store token in environment: %v", err)
+			}
+		} else {
+			t.Fatal("Signup did not return a token")
+		}
+	})
+
+	t.Run("Create a new project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Value: projectName,
+				},
+				&cli.StringFlag{
+					Name:  "belongs-to",
+					Value: "user",
+				},
+				&cli.BoolFlag{
+					Name:  "private",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdProject api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		projectID = createdProject.ID
+		assert.NotEmpty(t, projectID, "Project ID should be set")
+	})
+
+	var schemaID string
+	t.Run("Create schema from multi-file schema", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "OrderSchema",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Value: "jsonschema",
+				},
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: rootSchemaFilePath,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdSchema api.SchemaAPIResponse
+		err = json.Unmarshal([]byte(output), &createdSchema)
+		assert.Nil(t, err)
+		schemaID = createdSchema.ID
+		assert.NotEmpty(t, schemaID, "Schema ID should be set")
+	})
+
+	t.Run("Uploaded schema is bundled", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetSchemaVersionAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.BoolFlag{
+					Name:  "latest",
+					Value: true,
+				},
+				&cli.BoolFlag{
+					Name:  "schema-only",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "\"$ref\": \"#/$defs/customer\"")
+		assert.NotContains(t, output, "customer.json")
+	})
+}
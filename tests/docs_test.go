@@ -0,0 +1,307 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+func TestGenerateDocsAction(t *testing.T) {
+	// Load .env file
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+	projectName := "TestProjectForDocs"
+	catalogFile := "./testfiles/docs/catalog1.yaml"
+	var projectID string // To store the ID of the created project
+
+	readPage := func(t *testing.T, outDir string, path string) string {
+		page, err := os.ReadFile(filepath.Join(outDir, filepath.FromSlash(path)))
+		require.Nil(t, err, "Page %s should be generated", path)
+		return string(page)
+	}
+
+	t.Run("Sign up", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: newUniqueEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			if err := os.Setenv("FC_ACCESS_TOKEN", token); err != nil {
+				t.Fatalf("Failed to store token in environment: %v", err)
+			}
+		} else {
+			t.Fatal("Signup did not return a token")
+		}
+	})
+
+	t.Run("Create a new project to document", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Value: projectName,
+				},
+				&cli.StringFlag{
+					Name:  "belongs-to",
+					Value: "user",
+				},
+				&cli.BoolFlag{
+					Name:  "private",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdProject api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		projectID = createdProject.ID
+		assert.NotEmpty(t, projectID, "Project ID should be set")
+	})
+
+	t.Run("Import project definition to document", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Value: catalogFile,
+				},
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Generate Markdown catalog of project definition file", func(t *testing.T) {
+		outDir := t.TempDir()
+		output, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: catalogFile,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("Generated 8 pages in %s\n", outDir), output, "Every app, message, schema and server should get a page besides the index")
+
+		index := readPage(t, outDir, "index.md")
+		assert.True(t, strings.HasPrefix(index, "# Event catalog of catalog1\n"), "The title should default to the file name")
+		assert.Contains(t, index, "## Apps\n\n- [billing](apps/billing.md)\n- [shop](apps/shop.md)\n", "Entries should be sorted by name")
+		assert.Contains(t, index, "- [refund_requested](messages/refund_requested.md)")
+		assert.Contains(t, index, "- [mainkafka](servers/mainkafka.md)")
+	})
+
+	t.Run("Generate schema pages with properties and examples", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: catalogFile,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		page := readPage(t, outDir, "schemas/order.md")
+		for _, row := range []string{
+			"| customer | object | yes | Who placed the order |",
+			"| customer.name | string | yes |  |",
+			"| id | string (uuid) | yes | Order ID |",
+			"| lines | array | no |  |",
+			"| lines[].sku | string | no | Stock keeping unit |",
+			"| total | number | no | Total \\| incl. tax |",
+		} {
+			assert.Contains(t, page, row, "Properties should follow references, nesting and array items")
+		}
+		assert.Contains(t, page, "\"name\": \"Ada\"", "The example of the schema should be shown")
+		assert.Contains(t, page, "## Used by\n\n- [order_placed](../messages/order_placed.md)")
+
+		// Schemas without examples get a generated one
+		page = readPage(t, outDir, "schemas/refund.md")
+		assert.Contains(t, page, "## Example\n\n```json\n{\n  \"order_id\": \"9b2f7c8e-2f0d-4d4f-8a53-3a3a1f1b6c11\"\n}\n```")
+	})
+
+	t.Run("Generate message pages with producers and consumers", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: catalogFile,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		page := readPage(t, outDir, "messages/order_placed.md")
+		assert.Contains(t, page, "- [order (version 1)](../schemas/order.md)")
+		assert.Contains(t, page, "| [shop](../apps/shop.md) | sends | [async+kafka://mainkafka@readwrite/topic/orders](../servers/mainkafka.md#resource-orders) |")
+		assert.Contains(t, page, "| [billing](../apps/billing.md) | receives | [async+kafka://mainkafka@readwrite/topic/orders](../servers/mainkafka.md#resource-orders) |")
+
+		// The example of a CloudEvents message is wrapped in an envelope
+		assert.Contains(t, page, "| type | com.example.order.placed |")
+		assert.Contains(t, page, "| dataschema | any |")
+		assert.Contains(t, page, "\"data\": {\n    \"customer\": {")
+		assert.Contains(t, page, "\"specversion\": \"1.0\"")
+
+		page = readPage(t, outDir, "messages/refund_requested.md")
+		assert.Contains(t, page, "No app sends or receives this message.")
+		assert.NotContains(t, page, "## CloudEvents")
+	})
+
+	t.Run("Generate server pages with resource usage", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: catalogFile,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		page := readPage(t, outDir, "servers/mainkafka.md")
+		assert.Contains(t, page, "Protocol: async+kafka")
+		assert.Contains(t, page, "<a id=\"resource-orders\"></a>\n\n## Resource orders\n\ntopic in readwrite mode.", "Resource links of other pages should point at this anchor")
+		assert.Contains(t, page, "| [shop](../apps/shop.md) | sends | [order_placed](../messages/order_placed.md) |")
+		assert.Contains(t, page, "| [billing](../apps/billing.md) | receives | [order_placed](../messages/order_placed.md) |")
+
+		page = readPage(t, outDir, "apps/billing.md")
+		assert.Contains(t, page, "## Sends\n\nNone.")
+	})
+
+	t.Run("Generate HTML catalog with escaped text", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: catalogFile,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "html",
+				},
+				&cli.StringFlag{
+					Name:  "title",
+					Value: "Shop <events>",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		index := readPage(t, outDir, "index.html")
+		assert.Contains(t, index, "<title>Shop &lt;events&gt;</title>")
+		assert.Contains(t, index, "<a href=\"apps/shop.html\">shop</a>")
+
+		page := readPage(t, outDir, "apps/shop.html")
+		assert.Contains(t, page, "<p>Takes &lt;orders&gt; &amp; refunds</p>")
+		assert.Contains(t, page, "<a href=\"../servers/mainkafka.html#resource-orders\">async+kafka://mainkafka@readwrite/topic/orders</a>")
+		assert.Contains(t, page, "<nav><a href=\"../index.html\">Back to index</a></nav>")
+
+		page = readPage(t, outDir, "servers/mainkafka.html")
+		assert.Contains(t, page, "<h2 id=\"resource-orders\">Resource orders</h2>")
+	})
+
+	t.Run("Generate catalog of imported project with version history", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		index := readPage(t, outDir, "index.md")
+		assert.True(t, strings.HasPrefix(index, "# Event catalog\n"))
+
+		page := readPage(t, outDir, "schemas/order.md")
+		assert.Contains(t, page, "## Version history\n\n| Version | Created by | Created at |")
+		assert.Contains(t, page, "| customer.name | string | yes |  |")
+	})
+
+	t.Run("Generate catalog in unsupported format", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: catalogFile,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: t.TempDir(),
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "pdf",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported docs format \"pdf\"")
+	})
+}
@@ -84,42 +84,113 @@ func TestGenerateCodeAction(t *testing.T) {
 		projectID = createdProject.ID // Store the ID for the generate step
 	})
 
-	t.Run("Generate code for app", func(t *testing.T) {
-		assert.NotEmpty(t, projectID, "Project ID should be set before generate test")
+	t.Run("Import project to generate code for", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/validImport1.yaml",
+				},
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	var appID string
+	t.Run("Find app to generate code for", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ListAppsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+			},
+		})
+		assert.Nil(t, err)
 
-		// Create context with required values
-		ctx := context.WithValue(context.Background(), "project-id", projectID)
-		ctx = context.WithValue(ctx, "app-id", "test-app")
+		var apps []api.AppAPIResponse
+		err = json.Unmarshal([]byte(output), &apps)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, apps, "Should have at least one app after import")
+		appID = apps[0].ID
+	})
 
-		// Create generate command
-		generateCmd := &cli.Command{
-			Name: "generate",
-		}
+	t.Run("Generate code for app", func(t *testing.T) {
+		assert.NotEmpty(t, appID, "App ID should be set before generate test")
 
-		output, err := utils.CaptureOutputInTests(actions.GenerateCodeAction, ctx, generateCmd)
+		outputDir := t.TempDir()
+		output, err := utils.CaptureOutputInTests(actions.GenerateCodeAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "app-id",
+					Value: appID,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outputDir,
+				},
+			},
+		})
 		assert.Nil(t, err, "Generate code failed")
+		assert.Contains(t, output, fmt.Sprintf("files to %s", outputDir))
+
+		// Every listed file should have been downloaded
+		lines := strings.Split(strings.TrimSpace(output), "\n")
+		assert.Greater(t, len(lines), 1, "Generation should produce at least one file")
+		for _, line := range lines[1:] {
+			_, err := os.Stat(strings.TrimSpace(line))
+			assert.Nil(t, err, "Generated file should exist")
+		}
+	})
 
-		// Optional: Verify the output contains expected info
-		var generatedProject api.ProjectAPIResponse
-		err = json.Unmarshal([]byte(output), &generatedProject)
-		assert.Nil(t, err, "Failed to parse generated project response")
-		assert.Equal(t, projectID, generatedProject.ID, "Generated project ID should match the original")
-		assert.Equal(t, projectName, generatedProject.Name, "Generated project Name should match the original")
+	t.Run("Start code generation without waiting", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GenerateCodeAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "app-id",
+					Value: appID,
+				},
+				&cli.BoolFlag{
+					Name:  "no-wait",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var generation api.CodeGenerationAPIResponse
+		err = json.Unmarshal([]byte(output), &generation)
+		assert.Nil(t, err, "Failed to parse code generation response")
+		assert.Equal(t, appID, generation.AppID)
 	})
 
 	t.Run("Generate code with invalid app ID", func(t *testing.T) {
 		assert.NotEmpty(t, projectID, "Project ID should be set before generate test")
 
-		// Create context with required values
-		ctx := context.WithValue(context.Background(), "project-id", projectID)
-		ctx = context.WithValue(ctx, "app-id", "invalid-app-id")
-
-		// Create generate command
-		generateCmd := &cli.Command{
-			Name: "generate",
-		}
-
-		_, err := utils.CaptureOutputInTests(actions.GenerateCodeAction, ctx, generateCmd)
+		_, err := utils.CaptureOutputInTests(actions.GenerateCodeAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "app-id",
+					Value: "invalid-app-id",
+				},
+			},
+		})
 		assert.NotNil(t, err, "Expected an error for invalid app ID")
 		assert.Contains(t, err.Error(), "App not found", "Error message should indicate app not found")
 	})
@@ -0,0 +1,360 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/graph"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+func TestProjectGraphAction(t *testing.T) {
+	// Load .env file
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+	projectName := "TestProjectForGraph"
+	topologyFile := "./testfiles/graph/topology1.yaml"
+	var projectID string // To store the ID of the created project
+
+	// All flows of the topology, from producer to resource and from resource to consumer
+	allEdges := []graph.Edge{
+		{From: "app_billing", To: "resource_mainrabbit_invoices", Message: "invoice_created"},
+		{From: "app_shop", To: "resource_mainkafka_orders", Message: "order_placed"},
+		{From: "resource_mainkafka_orders", To: "app_billing", Message: "order_placed"},
+		{From: "resource_mainrabbit_invoices", To: "app_mailer", Message: "invoice_created"},
+	}
+	nodeIDs := func(topology graph.Graph) []string {
+		ids := make([]string, 0, len(topology.Nodes))
+		for _, node := range topology.Nodes {
+			ids = append(ids, node.ID)
+		}
+		return ids
+	}
+
+	t.Run("Sign up", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: newUniqueEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			if err := os.Setenv("FC_ACCESS_TOKEN", token); err != nil {
+				t.Fatalf("Failed to store token in environment: %v", err)
+			}
+		} else {
+			t.Fatal("Signup did not return a token")
+		}
+	})
+
+	t.Run("Create a new project to render", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Value: projectName,
+				},
+				&cli.StringFlag{
+					Name:  "belongs-to",
+					Value: "user",
+				},
+				&cli.BoolFlag{
+					Name:  "private",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdProject api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		projectID = createdProject.ID
+		assert.NotEmpty(t, projectID, "Project ID should be set")
+	})
+
+	t.Run("Import project definition to render", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Value: topologyFile,
+				},
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Render graph of project definition file", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: topologyFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var topology graph.Graph
+		err = json.Unmarshal([]byte(output), &topology)
+		require.Nil(t, err)
+		assert.Equal(t, []graph.Server{
+			{ID: "server_mainkafka", Name: "mainkafka", Protocol: "async+kafka"},
+			{ID: "server_mainrabbit", Name: "mainrabbit", Protocol: "async+amqp"},
+		}, topology.Servers)
+		assert.Equal(t, []string{
+			"app_audit",
+			"app_billing",
+			"app_mailer",
+			"app_shop",
+			"resource_mainkafka_archive",
+			"resource_mainkafka_orders",
+			"resource_mainrabbit_invoices",
+		}, nodeIDs(topology), "Apps and resources without flows should be part of the unfiltered graph")
+		assert.Equal(t, allEdges, topology.Edges)
+	})
+
+	t.Run("Render graph of imported project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var topology graph.Graph
+		err = json.Unmarshal([]byte(output), &topology)
+		require.Nil(t, err)
+		assert.Equal(t, allEdges, topology.Edges, "The imported project should have the flows of its definition")
+	})
+
+	t.Run("Render graph of an app in the middle of a flow", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: topologyFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+				&cli.StringFlag{
+					Name:  "app",
+					Value: "billing",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var topology graph.Graph
+		err = json.Unmarshal([]byte(output), &topology)
+		require.Nil(t, err)
+		assert.Equal(t, allEdges, topology.Edges, "Should show the producers and consumers the app talks to")
+		assert.NotContains(t, nodeIDs(topology), "app_audit")
+		assert.NotContains(t, nodeIDs(topology), "resource_mainkafka_archive")
+	})
+
+	t.Run("Render graph of an app at the end of a flow", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: topologyFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+				&cli.StringFlag{
+					Name:  "app",
+					Value: "mailer",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var topology graph.Graph
+		err = json.Unmarshal([]byte(output), &topology)
+		require.Nil(t, err)
+		assert.Equal(t, []graph.Edge{
+			{From: "app_billing", To: "resource_mainrabbit_invoices", Message: "invoice_created"},
+			{From: "resource_mainrabbit_invoices", To: "app_mailer", Message: "invoice_created"},
+		}, topology.Edges, "Flows of other messages of the producer should not be shown")
+		assert.Equal(t, []string{"app_billing", "app_mailer", "resource_mainrabbit_invoices"}, nodeIDs(topology))
+	})
+
+	t.Run("Render graph of a server", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: topologyFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+				&cli.StringFlag{
+					Name:  "server",
+					Value: "mainkafka",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var topology graph.Graph
+		err = json.Unmarshal([]byte(output), &topology)
+		require.Nil(t, err)
+		require.Len(t, topology.Servers, 1)
+		assert.Equal(t, "mainkafka", topology.Servers[0].Name)
+		assert.Equal(t, []graph.Edge{
+			{From: "app_shop", To: "resource_mainkafka_orders", Message: "order_placed"},
+			{From: "resource_mainkafka_orders", To: "app_billing", Message: "order_placed"},
+		}, topology.Edges)
+		assert.Equal(t, []string{"app_billing", "app_shop", "resource_mainkafka_orders"}, nodeIDs(topology))
+	})
+
+	t.Run("Render graph in every diagram format", func(t *testing.T) {
+		testCases := []struct {
+			format   string
+			expected []string
+		}{
+			{
+				format: "dot",
+				expected: []string{
+					"digraph project {",
+					"subgraph cluster_server_mainrabbit {\n    label=\"mainrabbit (async+amqp)\";\n    resource_mainrabbit_invoices [label=\"queue invoices\", shape=cylinder];\n  }",
+					"app_audit [label=\"audit\", shape=box, style=rounded];",
+					"app_shop -> resource_mainkafka_orders [label=\"order_placed\"];",
+					"resource_mainkafka_orders -> app_billing [label=\"order_placed\"];",
+				},
+			},
+			{
+				format: "mermaid",
+				expected: []string{
+					"flowchart LR",
+					"subgraph server_mainrabbit[\"mainrabbit (async+amqp)\"]\n    resource_mainrabbit_invoices[(\"queue invoices\")]\n  end",
+					"app_audit[\"audit\"]",
+					"app_shop -->|\"order_placed\"| resource_mainkafka_orders",
+					"resource_mainkafka_orders -->|\"order_placed\"| app_billing",
+				},
+			},
+			{
+				format: "plantuml",
+				expected: []string{
+					"@startuml",
+					"node \"mainrabbit (async+amqp)\" as server_mainrabbit {\n  queue \"queue invoices\" as resource_mainrabbit_invoices\n}",
+					"component \"audit\" as app_audit",
+					"app_shop --> resource_mainkafka_orders : order_placed",
+					"resource_mainkafka_orders --> app_billing : order_placed",
+					"@enduml",
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.format, func(t *testing.T) {
+				output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "file",
+							Value: topologyFile,
+						},
+						&cli.StringFlag{
+							Name:  "format",
+							Value: tc.format,
+						},
+					},
+				})
+				assert.Nil(t, err)
+				for _, expected := range tc.expected {
+					assert.Contains(t, output, expected)
+				}
+			})
+		}
+	})
+
+	t.Run("Write graph to a file", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "project.dot")
+
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: topologyFile,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outputPath,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("Project graph written to %s\n", outputPath), output)
+
+		data, err := os.ReadFile(outputPath)
+		require.Nil(t, err)
+		assert.True(t, strings.HasPrefix(string(data), "digraph project {"), "DOT should be the default format")
+	})
+
+	t.Run("Render graph of a file and a project at once", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: topologyFile,
+				},
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only one of --project-id and --file flags can be used at a time")
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/fusioncatalyst/paw/actions"
 	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/asyncapi"
+	"github.com/fusioncatalyst/paw/graph"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v3"
@@ -101,6 +104,551 @@ func TestImportProjectAction(t *testing.T) {
 
 	})
 
+	t.Run("Export imported project", func(t *testing.T) {
+		assert.NotEmpty(t, projectID, "Project ID should be set before export test")
+
+		output, err := utils.CaptureOutputInTests(actions.ExportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "mainkafka")
+		assert.Contains(t, output, "email_account_verification")
+		assert.Contains(t, output, "type: jsonschema")
+		assert.Contains(t, output, "account_verification_message")
+		assert.Contains(t, output, "backend_server")
+	})
+
+	t.Run("Apply project definition in dev mode", func(t *testing.T) {
+		assert.NotEmpty(t, projectID, "Project ID should be set before dev mode test")
+
+		output, err := utils.CaptureOutputInTests(actions.DevAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: validImportFilePathOriginal,
+				},
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringSliceFlag{
+					Name:  "app",
+					Value: []string{"backend_server"},
+				},
+				&cli.StringFlag{
+					Name:  "language",
+					Value: "go",
+				},
+				&cli.BoolFlag{
+					Name:  "once",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, fmt.Sprintf("Applied to project %s, generated go code for backend_server", projectID))
+	})
+
+	t.Run("Apply invalid project definition in dev mode", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.DevAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/invalidResources1.yaml",
+				},
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.BoolFlag{
+					Name:  "once",
+					Value: true,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Project definition is invalid")
+	})
+
+	t.Run("Render graph of imported project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+				&cli.StringFlag{
+					Name:  "app",
+					Value: "marketing_and_communications",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var topology graph.Graph
+		err = json.Unmarshal([]byte(output), &topology)
+		assert.Nil(t, err)
+		assert.Len(t, topology.Edges, 4, "Should show both producer and consumer edges of the app")
+	})
+
+	t.Run("Render graph of project definition file", func(t *testing.T) {
+		for _, format := range []string{"dot", "mermaid", "plantuml"} {
+			output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "file",
+						Value: validImportFilePathOriginal,
+					},
+					&cli.StringFlag{
+						Name:  "format",
+						Value: format,
+					},
+				},
+			})
+			assert.Nil(t, err)
+			assert.Contains(t, output, "app_backend_server", "Graph in %s format should contain the producer", format)
+			assert.Contains(t, output, "resource_mainkafka_emails", "Graph in %s format should contain the topic", format)
+		}
+	})
+
+	t.Run("Render graph with names differing only in punctuation", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/similarNames1.yaml",
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var topology graph.Graph
+		err = json.Unmarshal([]byte(output), &topology)
+		assert.Nil(t, err)
+		assert.Len(t, topology.Servers, 2, "Servers with similar names should stay separate")
+		assert.Len(t, topology.Nodes, 3, "Resources with similar names should stay separate")
+
+		ids := map[string]bool{}
+		for _, node := range topology.Nodes {
+			ids[node.ID] = true
+		}
+		assert.Len(t, ids, 3, "Every resource should have its own node ID")
+	})
+
+	t.Run("Render graph in unsupported format", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: validImportFilePathOriginal,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "svg",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported graph format")
+	})
+
+	t.Run("Generate event catalog of imported project", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "html",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		page, err := os.ReadFile(filepath.Join(outDir, "schemas", "email_account_verification.html"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(page), "Version history")
+		assert.Contains(t, string(page), "../messages/account_verification_message.html")
+	})
+
+	t.Run("Generate event catalog of project definition file", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: validImportFilePathOriginal,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		index, err := os.ReadFile(filepath.Join(outDir, "index.md"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(index), "(apps/backend_server.md)")
+
+		page, err := os.ReadFile(filepath.Join(outDir, "apps", "backend_server.md"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(page), "(../servers/mainkafka.md#resource-emails)")
+	})
+
+	t.Run("Generate event catalog with names differing only in case", func(t *testing.T) {
+		outDir := t.TempDir()
+		_, err := utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/similarNames1.yaml",
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		first, err := os.ReadFile(filepath.Join(outDir, "messages", "usercreated.md"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(first), "Message UserCreated")
+
+		second, err := os.ReadFile(filepath.Join(outDir, "messages", "usercreated-2.md"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(second), "Message usercreated")
+
+		index, err := os.ReadFile(filepath.Join(outDir, "index.md"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(index), "(messages/usercreated-2.md)")
+	})
+
+	t.Run("Export imported project as AsyncAPI", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ExportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var spec asyncapi.Document
+		err = json.Unmarshal([]byte(output), &spec)
+		assert.Nil(t, err)
+		assert.Equal(t, "3.0.0", spec.AsyncAPI)
+		assert.Equal(t, "kafka", spec.Servers["mainkafka"].Protocol)
+		assert.Contains(t, spec.Channels, "mainkafka_emails")
+		assert.Contains(t, spec.Components.Schemas, "email_account_verification")
+		assert.Len(t, spec.Operations, 4, "Should have an operation for every send and receive")
+	})
+
+	t.Run("Export app of project definition file as AsyncAPI 2.x", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ExportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: validImportFilePathOriginal,
+				},
+				&cli.StringFlag{
+					Name:  "app",
+					Value: "backend_server",
+				},
+				&cli.StringFlag{
+					Name:  "version",
+					Value: "2.6.0",
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "asyncapi: 2.6.0")
+		assert.Contains(t, output, "operationId: backend_server_send_emails")
+		assert.NotContains(t, output, "publish:", "The app only sends messages")
+	})
+
+	t.Run("Export unknown app as AsyncAPI", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ExportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: validImportFilePathOriginal,
+				},
+				&cli.StringFlag{
+					Name:  "app",
+					Value: "non_existent_app",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "app \"non_existent_app\" not found")
+	})
+
+	t.Run("Validate valid project definition file", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ValidateProjectFileAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: validImportFilePathOriginal,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Project definition is valid")
+	})
+
+	t.Run("Validate invalid project definition file", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ValidateProjectFileAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/invalidImport1.yaml",
+				},
+			},
+		})
+		assert.NotNil(t, err, "Expected an error for invalid project definition")
+		assert.Contains(t, err.Error(), "Project definition is invalid")
+		assert.Contains(t, err.Error(), "email_account_verification")
+		assert.Contains(t, err.Error(), "unknown schema \"email_password_reset\"")
+	})
+
+	t.Run("Import project with invalid file", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/invalidImport1.yaml",
+				},
+			},
+		})
+		assert.NotNil(t, err, "Expected an error for invalid project definition")
+		assert.Contains(t, err.Error(), "Project definition is invalid")
+		assert.Contains(t, err.Error(), "unknown schema \"email_password_reset\"")
+	})
+
+	t.Run("Import project with unknown keys", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/unknownKeys1.yaml",
+				},
+			},
+		})
+		assert.NotNil(t, err, "Expected an error for a misspelt key")
+		assert.Contains(t, err.Error(), "field resoruces not found")
+	})
+
+	t.Run("Validate project file with bindings", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ValidateProjectFileAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/validBinds1.yaml",
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Project definition is valid")
+	})
+
+	t.Run("Export CloudEvents messages of project definition file", func(t *testing.T) {
+		cloudEventsFilePath := "./testfiles/imports/validCloudEvents1.yaml"
+		output, err := utils.CaptureOutputInTests(actions.ExportAsyncAPIAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: cloudEventsFilePath,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "contentType: application/cloudevents+json")
+		assert.Contains(t, output, "const: com.example.order.placed")
+
+		outDir := t.TempDir()
+		_, err = utils.CaptureOutputInTests(actions.GenerateDocsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: cloudEventsFilePath,
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outDir,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		page, err := os.ReadFile(filepath.Join(outDir, "messages", "order_placed_event.md"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(page), "## CloudEvents")
+		assert.Contains(t, string(page), "\"specversion\": \"1.0\"")
+	})
+
+	t.Run("Validate project definition split over several files", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ValidateProjectFileAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/multi/project.yaml",
+				},
+				&cli.StringSliceFlag{
+					Name:  "var",
+					Value: []string{"EMAILS_TOPIC=emails"},
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Project definition is valid")
+
+		output, err = utils.CaptureOutputInTests(actions.ProjectGraphAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/multi/project.yaml",
+				},
+				&cli.StringSliceFlag{
+					Name:  "var",
+					Value: []string{"EMAILS_TOPIC=emails", "KAFKA_SERVER=eventbus"},
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "resource_eventbus_emails", "Variables should be substituted in included files")
+		assert.Contains(t, output, "app_marketing_and_communications", "Apps of all included files should be merged")
+	})
+
+	t.Run("Validate project definition with undefined variables", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ValidateProjectFileAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/multi/project.yaml",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "undefined variables: EMAILS_TOPIC")
+	})
+
+	t.Run("Validate project definition which includes itself", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ValidateProjectFileAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/multi/cycle.yaml",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "include cycle detected")
+	})
+
+	t.Run("Lint project definition file", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/lint/lintProblems1.yaml",
+				},
+			},
+		})
+		assert.Nil(t, err, "Warnings should not fail the lint")
+		assert.Contains(t, output, "[app-naming]")
+		assert.Contains(t, output, "[message-naming]")
+		assert.Contains(t, output, "[resource-naming]")
+		assert.Contains(t, output, "message \"OrderPlaced\" has no description")
+		assert.Contains(t, output, "message \"OrderPlaced\" is not received by any app")
+		assert.Contains(t, output, "schema \"order_cancelled\" is not used by any message")
+		assert.Contains(t, output, "schema \"order_placed\" has no examples")
+		assert.Contains(t, output, "use mode \"write\"")
+	})
+
+	t.Run("Lint project definition file with configured severities", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/lint/lintProblems1.yaml",
+				},
+				&cli.StringFlag{
+					Name:  "config",
+					Value: "./testfiles/lint/strict.pawlint.yaml",
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "sarif",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Lint failed with 1 errors")
+
+		var log map[string]interface{}
+		err = json.Unmarshal([]byte(output), &log)
+		assert.Nil(t, err)
+		assert.Equal(t, "2.1.0", log["version"])
+		assert.Contains(t, output, "\"ruleId\": \"app-naming\",\n          \"level\": \"error\"")
+		assert.Contains(t, output, "\"region\": {\n                  \"startLine\": 45,\n                  \"startColumn\": 5\n                }", "Results should point at the offending app")
+		assert.NotContains(t, output, "\"ruleId\": \"description-required\"")
+		assert.NotContains(t, output, "\"ruleId\": \"message-naming\"")
+	})
+
+	t.Run("Validate project file with broken resources", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ValidateProjectFileAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/invalidResources1.yaml",
+				},
+			},
+		})
+		assert.NotNil(t, err, "Expected an error for broken resource URIs")
+		assert.Contains(t, err.Error(), "uses protocol \"async+amqp\"")
+		assert.Contains(t, err.Error(), "unknown resource \"newsletters\"")
+		assert.Contains(t, err.Error(), "invalid resource URI \"mainkafka/topic/emails\"")
+		assert.Contains(t, err.Error(), "resource type \"exchange\" is not supported by async+kafka servers")
+		assert.Contains(t, err.Error(), "binds are not supported by async+kafka servers")
+		assert.Contains(t, err.Error(), "servers[1] (sensors): unknown server type \"async+mqtt\"")
+		assert.NotContains(t, err.Error(), "resources[0] (readings)")
+	})
+
 	t.Run("Import project with non-existent file", func(t *testing.T) {
 		assert.NotEmpty(t, projectID, "Project ID should be set before import test")
 
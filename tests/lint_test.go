@@ -0,0 +1,368 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/lint"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+// sarifTestLog is the part of a SARIF log the tests look at
+type sarifTestLog struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []struct {
+		Tool struct {
+			Driver struct {
+				Name  string `json:"name"`
+				Rules []struct {
+					ID                   string `json:"id"`
+					DefaultConfiguration struct {
+						Level string `json:"level"`
+					} `json:"defaultConfiguration"`
+				} `json:"rules"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Artifacts []struct {
+			Location struct {
+				URI string `json:"uri"`
+			} `json:"location"`
+			Contents struct {
+				Text string `json:"text"`
+			} `json:"contents"`
+		} `json:"artifacts"`
+		Results []struct {
+			RuleID  string `json:"ruleId"`
+			Level   string `json:"level"`
+			Message struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Locations []struct {
+				PhysicalLocation *struct {
+					ArtifactLocation struct {
+						URI string `json:"uri"`
+					} `json:"artifactLocation"`
+					Region *struct {
+						StartLine   int `json:"startLine"`
+						StartColumn int `json:"startColumn"`
+					} `json:"region"`
+				} `json:"physicalLocation"`
+				LogicalLocations []struct {
+					FullyQualifiedName string `json:"fullyQualifiedName"`
+				} `json:"logicalLocations"`
+			} `json:"locations"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+func TestLintAction(t *testing.T) {
+	// Load .env file
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+	projectName := "TestProjectForLint"
+	resourceModesFile := "./testfiles/lint/resourceModes1.yaml"
+	producersConsumersFile := "./testfiles/lint/producersConsumers1.yaml"
+	var projectID string // To store the ID of the created project
+
+	t.Run("Sign up", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: newUniqueEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			if err := os.Setenv("FC_ACCESS_TOKEN", token); err != nil {
+				t.Fatalf("Failed to store token in environment: %v", err)
+			}
+		} else {
+			t.Fatal("Signup did not return a token")
+		}
+	})
+
+	t.Run("Create a new project to lint", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Value: projectName,
+				},
+				&cli.StringFlag{
+					Name:  "belongs-to",
+					Value: "user",
+				},
+				&cli.BoolFlag{
+					Name:  "private",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdProject api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		projectID = createdProject.ID
+		assert.NotEmpty(t, projectID, "Project ID should be set")
+	})
+
+	t.Run("Import project definition to lint", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Value: resourceModesFile,
+				},
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Lint readwrite resources", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: resourceModesFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+			},
+		})
+		assert.Nil(t, err, "Warnings should not fail the lint")
+
+		var findings []lint.Finding
+		err = json.Unmarshal([]byte(output), &findings)
+		require.Nil(t, err)
+
+		// Resources used in both directions, not used at all or not readwrite are fine. The
+		// shared topics are told apart by their server.
+		expected := map[string]string{
+			"servers[0] (mainkafka): resources[0] (only_read)":    "topic \"only_read\" is only read by apps, use mode \"read\"",
+			"servers[0] (mainkafka): resources[1] (only_written)": "topic \"only_written\" is only written by apps, use mode \"write\"",
+			"servers[0] (mainkafka): resources[5] (shared)":       "topic \"shared\" is only written by apps, use mode \"write\"",
+			"servers[1] (backupkafka): resources[0] (shared)":     "topic \"shared\" is only read by apps, use mode \"read\"",
+		}
+		assert.Len(t, findings, len(expected))
+		for _, finding := range findings {
+			assert.Equal(t, "readwrite-resource", finding.Rule)
+			assert.Equal(t, lint.SeverityWarning, finding.Severity)
+			assert.Equal(t, expected[finding.Path], finding.Message, "Unexpected finding for %s", finding.Path)
+			if assert.NotNil(t, finding.Location, "Finding for %s should have a location", finding.Path) {
+				assert.Equal(t, resourceModesFile, finding.Location.File)
+			}
+		}
+	})
+
+	t.Run("Lint messages without producers or consumers", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: producersConsumersFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "json",
+				},
+			},
+		})
+		assert.Nil(t, err, "Warnings should not fail the lint")
+
+		var findings []lint.Finding
+		err = json.Unmarshal([]byte(output), &findings)
+		require.Nil(t, err)
+
+		// sent_and_received has both and is not reported
+		expected := map[string]string{
+			"messages[1] (only_sent)":     "message \"only_sent\" is not received by any app",
+			"messages[2] (only_received)": "message \"only_received\" is not sent by any app",
+			"messages[3] (unused)":        "message \"unused\" is neither sent nor received by any app",
+		}
+		assert.Len(t, findings, len(expected))
+		for _, finding := range findings {
+			assert.Equal(t, "message-producer-consumer", finding.Rule)
+			assert.Equal(t, expected[finding.Path], finding.Message, "Unexpected finding for %s", finding.Path)
+		}
+	})
+
+	t.Run("Lint project definition file as SARIF", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: resourceModesFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "sarif",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var log sarifTestLog
+		err = json.Unmarshal([]byte(output), &log)
+		require.Nil(t, err)
+		assert.Equal(t, "https://json.schemastore.org/sarif-2.1.0.json", log.Schema)
+		assert.Equal(t, "2.1.0", log.Version)
+		require.Len(t, log.Runs, 1)
+		run := log.Runs[0]
+
+		// Every built-in rule is described with its default level
+		assert.Equal(t, "paw", run.Tool.Driver.Name)
+		require.Len(t, run.Tool.Driver.Rules, len(lint.Rules))
+		for i, rule := range lint.Rules {
+			assert.Equal(t, rule.ID, run.Tool.Driver.Rules[i].ID)
+			assert.Equal(t, string(rule.Severity), run.Tool.Driver.Rules[i].DefaultConfiguration.Level)
+		}
+
+		// Files are referenced by their path, their contents are not embedded
+		assert.Empty(t, run.Artifacts)
+
+		require.Len(t, run.Results, 4)
+		result := run.Results[0]
+		assert.Equal(t, "readwrite-resource", result.RuleID)
+		assert.Equal(t, "warning", result.Level)
+		assert.Equal(t, "topic \"only_read\" is only read by apps, use mode \"read\"", result.Message.Text)
+		require.Len(t, result.Locations, 1)
+		location := result.Locations[0]
+		require.NotNil(t, location.PhysicalLocation)
+		assert.Equal(t, "testfiles/lint/resourceModes1.yaml", location.PhysicalLocation.ArtifactLocation.URI)
+		require.NotNil(t, location.PhysicalLocation.Region)
+		assert.Equal(t, 10, location.PhysicalLocation.Region.StartLine)
+		assert.Equal(t, 9, location.PhysicalLocation.Region.StartColumn)
+		require.Len(t, location.LogicalLocations, 1)
+		assert.Equal(t, "servers[0] (mainkafka): resources[0] (only_read)", location.LogicalLocations[0].FullyQualifiedName)
+	})
+
+	t.Run("Lint project definition file as SARIF without findings", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: resourceModesFile,
+				},
+				&cli.StringFlag{
+					Name:  "config",
+					Value: "./testfiles/lint/noReadWrite.pawlint.yaml",
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "sarif",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var log sarifTestLog
+		err = json.Unmarshal([]byte(output), &log)
+		require.Nil(t, err)
+		require.Len(t, log.Runs, 1)
+		assert.Empty(t, log.Runs[0].Results)
+		assert.Contains(t, output, "\"results\": []", "Code scanning tools expect an empty list rather than null")
+	})
+
+	t.Run("Lint live project as SARIF", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "sarif",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var log sarifTestLog
+		err = json.Unmarshal([]byte(output), &log)
+		require.Nil(t, err)
+		require.Len(t, log.Runs, 1)
+		run := log.Runs[0]
+
+		// The exported definition has no file, so it is embedded and results point into it
+		artifactURI := projectID + ".yaml"
+		require.Len(t, run.Artifacts, 1)
+		assert.Equal(t, artifactURI, run.Artifacts[0].Location.URI)
+		assert.Contains(t, run.Artifacts[0].Contents.Text, "only_read")
+
+		rules := map[string]bool{}
+		for _, result := range run.Results {
+			rules[result.RuleID] = true
+			require.Len(t, result.Locations, 1)
+			if assert.NotNil(t, result.Locations[0].PhysicalLocation) {
+				assert.Equal(t, artifactURI, result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+			}
+		}
+		assert.True(t, rules["readwrite-resource"], "Readwrite resources of the imported project should be reported")
+	})
+
+	t.Run("Write SARIF results to a file", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "lint.sarif")
+
+		output, err := utils.CaptureOutputInTests(actions.LintAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "file",
+					Value: producersConsumersFile,
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Value: "sarif",
+				},
+				&cli.StringFlag{
+					Name:  "out",
+					Value: outputPath,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("Lint results written to %s\n", outputPath), output)
+
+		data, err := os.ReadFile(outputPath)
+		require.Nil(t, err)
+		var log sarifTestLog
+		err = json.Unmarshal(data, &log)
+		require.Nil(t, err)
+		require.Len(t, log.Runs, 1)
+		assert.Len(t, log.Runs[0].Results, 3)
+	})
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/fusioncatalyst/paw/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadVariables(t *testing.T) {
+	vars := map[string]string{
+		"VERSION":            "1",
+		"SERVER_DESCRIPTION": "primary: eu-west # shared\nsecond line",
+		"TOPIC":              "users",
+	}
+
+	t.Run("Variables are substituted in values", func(t *testing.T) {
+		document, err := provision.LoadWithOptions("./testfiles/imports/variables1.yaml", provision.LoadOptions{Vars: vars})
+		require.NoError(t, err)
+		assert.Equal(t, 1, document.Version)
+		require.Len(t, document.Servers, 1)
+		assert.Equal(t, "primary: eu-west # shared\nsecond line", document.Servers[0].Description, "Values must not change the structure of the document")
+		require.Len(t, document.Servers[0].Resources, 1)
+		assert.Equal(t, "users", document.Servers[0].Resources[0].Name)
+	})
+
+	t.Run("Comments and inline schemas are left as they are", func(t *testing.T) {
+		document, err := provision.LoadWithOptions("./testfiles/imports/variables1.yaml", provision.LoadOptions{Vars: vars})
+		require.NoError(t, err)
+		require.Len(t, document.Schemas, 1)
+		assert.Contains(t, document.Schemas[0].Schema, "^${PREFIX}-[0-9]+$")
+	})
+
+	t.Run("Undefined variables in values fail the load", func(t *testing.T) {
+		_, err := provision.LoadWithOptions("./testfiles/imports/variables1.yaml", provision.LoadOptions{Vars: map[string]string{"VERSION": "1"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "undefined variables: SERVER_DESCRIPTION, TOPIC")
+	})
+}
+
+func TestLoadLocations(t *testing.T) {
+	document, locations, err := provision.LoadWithLocations("./testfiles/imports/multi/project.yaml", provision.LoadOptions{Vars: map[string]string{"EMAILS_TOPIC": "emails"}})
+	require.NoError(t, err)
+
+	t.Run("Entries of the including file", func(t *testing.T) {
+		location := locations.Schema(0)
+		require.NotNil(t, location)
+		assert.Equal(t, provision.Location{File: "./testfiles/imports/multi/project.yaml", Line: 9, Column: 5}, *location)
+	})
+
+	t.Run("Entries of included files", func(t *testing.T) {
+		require.Len(t, document.Servers, 1)
+		location := locations.Resource(0, 0)
+		require.NotNil(t, location)
+		assert.Equal(t, "testfiles/imports/multi/servers.yaml", location.File)
+		assert.Equal(t, 6, location.Line)
+		assert.Equal(t, 9, location.Column)
+
+		require.NotEmpty(t, document.Apps)
+		assert.Len(t, locations.Apps, len(document.Apps), "Every app should have a location")
+	})
+
+	t.Run("Unknown entries have no location", func(t *testing.T) {
+		assert.Nil(t, locations.Message(len(document.Messages)))
+		assert.Nil(t, locations.Resource(len(document.Servers), 0))
+	})
+}
+
+func TestLoadSourcesOfGlobIncludes(t *testing.T) {
+	directory := t.TempDir()
+	write := func(name string, content string) {
+		path := filepath.Join(directory, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	write("project.yaml", "version: 1\ninclude:\n  - apps/*.yaml\n")
+	write("apps/billing.yaml", "apps:\n  - name: billing\n")
+
+	document, sources, err := provision.LoadWithSources(filepath.Join(directory, "project.yaml"), provision.LoadOptions{})
+	require.NoError(t, err)
+	require.Len(t, document.Apps, 1)
+	assert.Contains(t, sources, filepath.Join(directory, "apps"), "Directories of glob includes should be watched")
+
+	snapshot := watch.Take(sources)
+	// Directory timestamps may have a coarse resolution
+	time.Sleep(10 * time.Millisecond)
+	write("apps/shipping.yaml", "apps:\n  - name: shipping\n")
+	assert.NotEqual(t, snapshot, watch.Take(sources), "A new file matching a glob include should change the sources")
+
+	document, _, err = provision.LoadWithSources(filepath.Join(directory, "project.yaml"), provision.LoadOptions{})
+	require.NoError(t, err)
+	assert.Len(t, document.Apps, 2)
+}
@@ -237,6 +237,370 @@ func TestMessageActions(t *testing.T) {
 		assert.Contains(t, messageNames, "UserUpdated")
 	})
 
+	t.Run("Generate sample payloads for a message", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SampleMessageAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "UserCreated",
+				},
+				&cli.IntFlag{
+					Name:  "count",
+					Value: 5,
+				},
+				&cli.BoolFlag{
+					Name:  "ndjson",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		lines := strings.Split(strings.TrimSpace(output), "\n")
+		assert.Len(t, lines, 5, "Should print one payload per line")
+		for _, line := range lines {
+			var payload map[string]interface{}
+			err = json.Unmarshal([]byte(line), &payload)
+			assert.Nil(t, err)
+			assert.Contains(t, payload, "firstName")
+			assert.Contains(t, payload, "lastName")
+		}
+	})
+
+	t.Run("Generate sample payloads for a non-existing message", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.SampleMessageAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "NonExistingMessage",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("Update message description", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.UpdateMessageAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "UserUpdated",
+				},
+				&cli.StringFlag{
+					Name:  "description",
+					Value: "Message sent when a user profile is updated",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var updatedMessage api.MessageAPIResponse
+		err = json.Unmarshal([]byte(output), &updatedMessage)
+		assert.Nil(t, err)
+		assert.Equal(t, "Message sent when a user profile is updated", updatedMessage.Description)
+		assert.Equal(t, 1, updatedMessage.SchemaVersion)
+	})
+
+	t.Run("Update message to a non-existing schema version", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.UpdateMessageAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "UserUpdated",
+				},
+				&cli.IntFlag{
+					Name:  "schema-version",
+					Value: 42,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Schema version 42 is not available")
+	})
+
+	t.Run("Bump messages when schema is up to date", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.BumpMessagesAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.BoolFlag{
+					Name:  "to-latest",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "All messages already use the latest schema version")
+	})
+
+	t.Run("Create a new schema version", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.UpdateSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: schemaFilePath,
+				},
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Bump messages of a schema in dry-run mode", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.BumpMessagesAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.BoolFlag{
+					Name:  "to-latest",
+					Value: true,
+				},
+				&cli.BoolFlag{
+					Name:  "dry-run",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "UserCreated: schema version 1 -> 2")
+		assert.Contains(t, output, "UserUpdated: schema version 1 -> 2")
+	})
+
+	t.Run("Bump a single message", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.BumpMessagesAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "UserUpdated",
+				},
+				&cli.BoolFlag{
+					Name:  "to-latest",
+					Value: true,
+				},
+				&cli.BoolFlag{
+					Name:  "yes",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Bumped UserUpdated to schema version 2")
+	})
+
+	t.Run("Validate valid payloads against a message", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ValidateMessagePayloadsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "message",
+					Value: "UserCreated",
+				},
+				&cli.StringSliceFlag{
+					Name:  "file",
+					Value: []string{"testfiles/payloads/validUsers.ndjson"},
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "All 2 documents are valid")
+	})
+
+	t.Run("Validate invalid payloads against a message", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ValidateMessagePayloadsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "message",
+					Value: "UserCreated",
+				},
+				&cli.StringSliceFlag{
+					Name:  "file",
+					Value: []string{"testfiles/payloads/invalidUsers.ndjson"},
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "2 of 3 documents failed validation")
+		assert.Contains(t, output, "invalidUsers.ndjson:1: valid")
+		assert.Contains(t, output, "/age")
+		assert.Contains(t, output, "invalid JSON")
+	})
+
+	t.Run("Create CloudEvents message", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateMessageAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "UserDeleted",
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.IntFlag{
+					Name:  "schema-version",
+					Value: 1,
+				},
+				&cli.BoolFlag{
+					Name:  "cloudevents",
+					Value: true,
+				},
+				&cli.StringFlag{
+					Name:  "ce-source",
+					Value: "/users",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdMessage api.MessageAPIResponse
+		err = json.Unmarshal([]byte(output), &createdMessage)
+		assert.Nil(t, err)
+		if assert.NotNil(t, createdMessage.CloudEvents) {
+			assert.Equal(t, "UserDeleted", createdMessage.CloudEvents.Type, "Type should default to the message name")
+			assert.Equal(t, "/users", createdMessage.CloudEvents.Source)
+		}
+	})
+
+	t.Run("Validate CloudEvents envelopes against a message", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ValidateMessagePayloadsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "message",
+					Value: "UserDeleted",
+				},
+				&cli.StringSliceFlag{
+					Name:  "file",
+					Value: []string{"testfiles/payloads/userEvents.ndjson"},
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "3 of 4 documents failed validation")
+		assert.Contains(t, output, "userEvents.ndjson:1: valid")
+		assert.Contains(t, output, "/type: expected \"UserDeleted\", got \"UserRemoved\"")
+		assert.Contains(t, output, "/data/age")
+		assert.Contains(t, output, "missing required attribute \"id\"")
+	})
+
+	t.Run("Validate CloudEvents envelopes with unsupported data", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ValidateMessagePayloadsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "message",
+					Value: "UserDeleted",
+				},
+				&cli.StringSliceFlag{
+					Name:  "file",
+					Value: []string{"testfiles/payloads/userEventEnvelopes.ndjson"},
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "5 of 6 documents failed validation")
+		assert.Contains(t, output, "userEventEnvelopes.ndjson:1: valid", "JSON content types with a suffix and parameters should be accepted")
+		assert.Contains(t, output, "/datacontenttype: expected a JSON content type, got \"text/plain\"")
+		assert.Contains(t, output, "/data_base64: binary data cannot be validated against the message schema")
+		assert.Contains(t, output, "/: missing attribute \"data\"")
+		assert.Contains(t, output, "/id: must not be empty")
+		assert.Contains(t, output, "/specversion: must be a string")
+	})
+
+	t.Run("Generate CloudEvents samples for a message", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SampleMessageAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "UserDeleted",
+				},
+				&cli.IntFlag{
+					Name:  "count",
+					Value: 2,
+				},
+				&cli.BoolFlag{
+					Name:  "ndjson",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		lines := strings.Split(strings.TrimSpace(output), "\n")
+		assert.Len(t, lines, 2)
+		for _, line := range lines {
+			var event map[string]interface{}
+			err := json.Unmarshal([]byte(line), &event)
+			assert.Nil(t, err)
+			assert.Equal(t, "1.0", event["specversion"])
+			assert.Equal(t, "UserDeleted", event["type"])
+			assert.Equal(t, "/users", event["source"])
+			assert.NotEmpty(t, event["id"])
+			assert.Contains(t, event, "data")
+		}
+	})
+
 	t.Run("Create message with missing required fields", func(t *testing.T) {
 		testCases := []struct {
 			name          string
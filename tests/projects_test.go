@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 	"github.com/fusioncatalyst/paw/utils"
 
 	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/api"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v3"
@@ -43,6 +45,7 @@ func TestProjectsAction(t *testing.T) {
 	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
 	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
 	testPassword := "password123"
+	var projectID string // To store the ID of the created project
 
 	t.Run("List projects without access token", func(t *testing.T) {
 		// Create projects command with list subcommand
@@ -132,8 +135,13 @@ func TestProjectsAction(t *testing.T) {
 			},
 		}
 
-		_, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), projectsCmd.Commands[0])
+		output, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), projectsCmd.Commands[0])
 		assert.Nil(t, err)
+
+		var createdProject api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		projectID = createdProject.ID
 	})
 
 	t.Run("List projects after creation. TestProject should be in the list", func(t *testing.T) {
@@ -151,4 +159,303 @@ func TestProjectsAction(t *testing.T) {
 		output, _ := utils.CaptureOutputInTests(actions.ListProjectsAction, context.Background(), projectsCmd.Commands[0])
 		assert.Contains(t, output, "TestProject", "Expected to see TestProject in the list of projects")
 	})
+
+	t.Run("Get project with counts", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var project struct {
+			api.ProjectAPIResponse
+			Counts map[string]int `json:"counts"`
+		}
+		err = json.Unmarshal([]byte(output), &project)
+		assert.Nil(t, err)
+		assert.Equal(t, "TestProject", project.Name)
+		assert.True(t, project.IsPrivate)
+		assert.Equal(t, map[string]int{"apps": 0, "schemas": 0, "messages": 0, "servers": 0}, project.Counts)
+	})
+
+	t.Run("Update project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.UpdateProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "RenamedTestProject",
+				},
+				&cli.StringFlag{
+					Name:  "visibility",
+					Value: "public",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var project api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &project)
+		assert.Nil(t, err)
+		assert.Equal(t, "RenamedTestProject", project.Name)
+		assert.Equal(t, "Test project description", project.Description)
+		assert.False(t, project.IsPrivate)
+	})
+
+	t.Run("Update project without changes", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.UpdateProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Nothing to update")
+	})
+
+	var workspaceID string
+	t.Run("Create workspace to transfer the project to", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateWorkspaceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "TransferWorkspace",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var workspace api.WorkspaceAPIResponse
+		err = json.Unmarshal([]byte(output), &workspace)
+		assert.Nil(t, err)
+		workspaceID = workspace.ID
+		assert.NotEmpty(t, workspaceID, "Workspace ID should be set")
+	})
+
+	t.Run("Transfer project to unknown workspace", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.TransferProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "to-workspace",
+					Value: "NoSuchWorkspace",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Workspace NoSuchWorkspace not found among your workspaces")
+	})
+
+	t.Run("Transfer project to unknown user", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.TransferProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "to-user",
+					Value: "nosuchuser@testmail.com",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "User nosuchuser@testmail.com not found")
+	})
+
+	t.Run("Transfer project to workspace with dry run", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.TransferProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "to-workspace",
+					Value: "TransferWorkspace",
+				},
+				&cli.BoolFlag{
+					Name:  "dry-run",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, fmt.Sprintf("-> workspace TransferWorkspace (%s)", workspaceID))
+		assert.NotContains(t, output, "Transferred project")
+
+		client, err := api.NewFCApiClient()
+		assert.Nil(t, err)
+		unchanged, err := client.GetProject(projectID)
+		assert.Nil(t, err)
+		assert.Equal(t, "user", unchanged.CreatedByType)
+	})
+
+	t.Run("Transfer project to workspace", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.TransferProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "to-workspace",
+					Value: "TransferWorkspace",
+				},
+				&cli.BoolFlag{
+					Name:  "yes",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Transferred project RenamedTestProject to workspace TransferWorkspace")
+
+		output, err = utils.CaptureOutputInTests(actions.ListWorkspaceProjectsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, projectID)
+	})
+
+	t.Run("Transfer project to its current owner", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.TransferProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "to-workspace",
+					Value: workspaceID,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "already belongs to workspace TransferWorkspace")
+	})
+
+	t.Run("Transfer project back to user", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.TransferProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "to-user",
+					Value: "me",
+				},
+				&cli.BoolFlag{
+					Name:  "yes",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "(you)")
+
+		output, err = utils.CaptureOutputInTests(actions.GetProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var project api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &project)
+		assert.Nil(t, err)
+		assert.Equal(t, "user", project.CreatedByType)
+	})
+
+	t.Run("Archive project with wrong name", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ArchiveProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "confirm",
+					Value: "TestProject",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the project name does not match")
+	})
+
+	t.Run("Archive project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ArchiveProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "confirm",
+					Value: "RenamedTestProject",
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Project RenamedTestProject archived")
+	})
+
+	t.Run("Delete project with wrong name", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.DeleteProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "confirm",
+					Value: "TestProject",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the project name does not match")
+	})
+
+	t.Run("Delete project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.DeleteProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "confirm",
+					Value: "RenamedTestProject",
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Project RenamedTestProject deleted")
+
+		output, _ = utils.CaptureOutputInTests(actions.ListProjectsAction, context.Background(), &cli.Command{})
+		assert.NotContains(t, output, "RenamedTestProject")
+	})
 }
@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+func TestPromoteProjectAction(t *testing.T) {
+	// Load .env file
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+	var stagingProjectID, prodProjectID string
+
+	mappingFilePath := "./testfiles/promote/prodMapping.yaml"
+
+	t.Run("Sign up", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: newUniqueEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			if err := os.Setenv("FC_ACCESS_TOKEN", token); err != nil {
+				t.Fatalf("Failed to store token in environment: %v", err)
+			}
+		} else {
+			t.Fatal("Signup did not return a token")
+		}
+	})
+
+	createProject := func(t *testing.T, name string) string {
+		output, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Value: name,
+				},
+				&cli.StringFlag{
+					Name:  "belongs-to",
+					Value: "user",
+				},
+				&cli.BoolFlag{
+					Name:  "private",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdProject api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, createdProject.ID, "Project ID should be set")
+		return createdProject.ID
+	}
+
+	t.Run("Create staging and prod projects", func(t *testing.T) {
+		stagingProjectID = createProject(t, "TestProjectForPromotionStaging")
+		prodProjectID = createProject(t, "TestProjectForPromotionProd")
+	})
+
+	t.Run("Import staging project", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.ImportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: stagingProjectID,
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Value: "./testfiles/imports/validImport1.yaml",
+				},
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	promoteFlags := func(extra ...cli.Flag) []cli.Flag {
+		return append([]cli.Flag{
+			&cli.StringFlag{
+				Name:  "from",
+				Value: stagingProjectID,
+			},
+			&cli.StringFlag{
+				Name:  "to",
+				Value: prodProjectID,
+			},
+			&cli.StringFlag{
+				Name:  "mapping",
+				Value: mappingFilePath,
+			},
+		}, extra...)
+	}
+
+	t.Run("Show promotion plan", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.PromoteProjectAction, context.Background(), &cli.Command{
+			Flags: promoteFlags(&cli.BoolFlag{
+				Name:  "dry-run",
+				Value: true,
+			}),
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "+ server prodkafka")
+		assert.Contains(t, output, "+ resource prodkafka/prod.emails")
+		assert.Contains(t, output, "+ schema email_account_verification")
+		assert.Contains(t, output, "+ app backend_server")
+	})
+
+	t.Run("Promote staging project to prod", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.PromoteProjectAction, context.Background(), &cli.Command{
+			Flags: promoteFlags(&cli.BoolFlag{
+				Name:  "yes",
+				Value: true,
+			}),
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, fmt.Sprintf("Promoted project %s to project %s", stagingProjectID, prodProjectID))
+	})
+
+	t.Run("Prod project uses mapped resources", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ExportProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: prodProjectID,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "async+kafka://prodkafka@readwrite/topic/prod.emails")
+		assert.NotContains(t, output, "mainkafka")
+	})
+
+	t.Run("Promote up to date project by name", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.PromoteProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "from",
+					Value: "TestProjectForPromotionStaging",
+				},
+				&cli.StringFlag{
+					Name:  "to",
+					Value: "TestProjectForPromotionProd",
+				},
+				&cli.StringFlag{
+					Name:  "mapping",
+					Value: mappingFilePath,
+				},
+				&cli.BoolFlag{
+					Name:  "compatible-only",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "is up to date with project")
+	})
+
+	t.Run("Promote with mapping of unknown server", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.PromoteProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "from",
+					Value: prodProjectID,
+				},
+				&cli.StringFlag{
+					Name:  "to",
+					Value: stagingProjectID,
+				},
+				&cli.StringFlag{
+					Name:  "mapping",
+					Value: mappingFilePath,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "mapped server \"mainkafka\" does not exist")
+	})
+}
+
+func TestPromoteMappingOfBrokerNames(t *testing.T) {
+	document := &contracts.ProvisionYAMLFile{
+		Version: 1,
+		Servers: []contracts.ProvisionServer{{
+			Name: "devkafka",
+			Type: "async+kafka",
+			Resources: []contracts.ServerResource{
+				{Name: "emails", Mode: "readwrite", Type: "topic", ResourceName: "dev.emails.v1"},
+				{Name: "orders", Mode: "readwrite", Type: "topic", ResourceName: "dev.orders.v1"},
+				{Name: "payments", Mode: "readwrite", Type: "topic"},
+			},
+		}},
+	}
+	mapping := &provision.Mapping{
+		Resources: map[string]string{"devkafka/emails": "notifications"},
+		Prefixes:  []provision.PrefixMapping{{From: "dev.", To: "prod."}},
+	}
+
+	require.NoError(t, mapping.Apply(document))
+	resources := document.Servers[0].Resources
+	assert.Equal(t, "notifications", resources[0].Name)
+	assert.Equal(t, "notifications", resources[0].ResourceName, "An explicit mapping should also name the resource on the broker")
+	assert.Equal(t, "orders", resources[1].Name)
+	assert.Equal(t, "prod.orders.v1", resources[1].ResourceName)
+	assert.Equal(t, "", resources[2].ResourceName, "Resources without a broker name should keep using their name")
+}
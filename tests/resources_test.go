@@ -29,6 +29,8 @@ func TestResourceManagement(t *testing.T) {
 
 	var projectID string
 	var serverID string
+	var resourceID string
+	serverName := fmt.Sprintf("testserver%s", currentTimestamp[:10])
 
 	output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
 		Flags: []cli.Flag{
@@ -62,7 +64,6 @@ func TestResourceManagement(t *testing.T) {
 	projectID = createdProject.ID
 
 	t.Run("Create server for resources", func(t *testing.T) {
-		serverName := fmt.Sprintf("testserver%s", currentTimestamp[:10])
 		serverType := "async+kafka"
 		serverDescription := "Test Kafka server for resource testing"
 
@@ -111,6 +112,132 @@ func TestResourceManagement(t *testing.T) {
 		assert.Equal(t, resourceMode, resource.Mode)
 		assert.Equal(t, resourceDescription, resource.Description)
 		assert.Equal(t, serverID, resource.ServerID)
+		resourceID = resource.ID
+	})
+
+	t.Run("Create resources not supported by the server protocol", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			resourceType  string
+			resourceMode  string
+			expectedError string
+		}{
+			{
+				name:          "exchange on kafka server",
+				resourceType:  "exchange",
+				resourceMode:  "readwrite",
+				expectedError: "resource type \"exchange\" is not supported by async+kafka servers. Must be one of: topic",
+			},
+			{
+				name:          "bind mode on kafka server",
+				resourceType:  "topic",
+				resourceMode:  "bind",
+				expectedError: "resource mode \"bind\" is not supported by async+kafka servers",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := utils.CaptureOutputInTests(actions.CreateResourceAction, context.Background(), &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "server-id", Value: serverID},
+						&cli.StringFlag{Name: "name", Value: "unsupported"},
+						&cli.StringFlag{Name: "type", Value: tc.resourceType},
+						&cli.StringFlag{Name: "mode", Value: tc.resourceMode},
+					},
+				})
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			})
+		}
+	})
+
+	t.Run("Create resource from a resource URI", func(t *testing.T) {
+		resourceURI := fmt.Sprintf("async+kafka://%s@read/topic/uritopic", serverName)
+
+		output, err := utils.CaptureOutputInTests(actions.CreateResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "project-id", Value: projectID},
+				&cli.StringFlag{Name: "resource", Value: resourceURI},
+			},
+		})
+		assert.Nil(t, err, "Failed to create resource")
+
+		var resource contracts.ResourceResponse
+		err = json.Unmarshal([]byte(output), &resource)
+		assert.Nil(t, err, "Failed to parse resource response")
+		assert.Equal(t, "uritopic", resource.Name)
+		assert.Equal(t, "topic", resource.ResourceType)
+		assert.Equal(t, "read", resource.Mode)
+		assert.Equal(t, serverID, resource.ServerID)
+	})
+
+	t.Run("Create resource from invalid resource URIs", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			uri           string
+			expectedError string
+		}{
+			{
+				name:          "malformed URI",
+				uri:           "mainkafka/topic/emails",
+				expectedError: "invalid resource URI",
+			},
+			{
+				name:          "unknown resource mode",
+				uri:           fmt.Sprintf("async+kafka://%s@sometimes/topic/emails", serverName),
+				expectedError: "unknown resource mode",
+			},
+			{
+				name:          "protocol mismatch",
+				uri:           fmt.Sprintf("async+amqp://%s@read/queue/emails", serverName),
+				expectedError: "uses protocol",
+			},
+			{
+				name:          "unknown server",
+				uri:           "async+kafka://unknownserver@read/topic/emails",
+				expectedError: "not found in project",
+			},
+			{
+				name:          "existing resource",
+				uri:           fmt.Sprintf("async+kafka://%s@read/topic/uritopic", serverName),
+				expectedError: "already exists",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := utils.CaptureOutputInTests(actions.CreateResourceAction, context.Background(), &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "project-id", Value: projectID},
+						&cli.StringFlag{Name: "resource", Value: tc.uri},
+					},
+				})
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			})
+		}
+	})
+
+	t.Run("Use resource URIs without a project", func(t *testing.T) {
+		resourceURI := fmt.Sprintf("async+kafka://%s@read/topic/uritopic", serverName)
+		for name, action := range map[string]cli.ActionFunc{
+			"new":    actions.CreateResourceAction,
+			"get":    actions.GetResourceAction,
+			"update": actions.UpdateResourceAction,
+			"delete": actions.DeleteResourceAction,
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := utils.CaptureOutputInTests(action, context.Background(), &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "resource", Value: resourceURI},
+						&cli.StringFlag{Name: "description", Value: "Not updated"},
+					},
+				})
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "Project ID is required with --resource")
+			})
+		}
 	})
 
 	t.Run("List resources", func(t *testing.T) {
@@ -126,4 +253,108 @@ func TestResourceManagement(t *testing.T) {
 		assert.Nil(t, err, "Failed to parse resources list")
 		assert.GreaterOrEqual(t, len(resources), 1, "Should have at least one resource")
 	})
+
+	t.Run("Get resource by ID", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "resource-id", Value: resourceID},
+			},
+		})
+		assert.Nil(t, err, "Failed to get resource")
+
+		var resource contracts.ResourceResponse
+		err = json.Unmarshal([]byte(output), &resource)
+		assert.Nil(t, err, "Failed to parse resource response")
+		assert.Equal(t, resourceID, resource.ID)
+	})
+
+	t.Run("Get resource by URI", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "project-id", Value: projectID},
+				&cli.StringFlag{Name: "resource", Value: fmt.Sprintf("async+kafka://%s@read/topic/uritopic", serverName)},
+			},
+		})
+		assert.Nil(t, err, "Failed to get resource")
+
+		var resource contracts.ResourceResponse
+		err = json.Unmarshal([]byte(output), &resource)
+		assert.Nil(t, err, "Failed to parse resource response")
+		assert.Equal(t, "uritopic", resource.Name)
+	})
+
+	t.Run("Update resource", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.UpdateResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "resource-id", Value: resourceID},
+				&cli.StringFlag{Name: "description", Value: "Updated description"},
+				&cli.StringFlag{Name: "mode", Value: "read"},
+				&cli.BoolFlag{Name: "yes", Value: true},
+			},
+		})
+		assert.Nil(t, err, "Failed to update resource")
+
+		var resource contracts.ResourceResponse
+		err = json.Unmarshal([]byte(output), &resource)
+		assert.Nil(t, err, "Failed to parse resource response")
+		assert.Equal(t, "Updated description", resource.Description)
+		assert.Equal(t, "read", resource.Mode)
+	})
+
+	t.Run("Update resource with a mode not supported by the server", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.UpdateResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "resource-id", Value: resourceID},
+				&cli.StringFlag{Name: "mode", Value: "bind"},
+				&cli.BoolFlag{Name: "yes", Value: true},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "resource mode \"bind\" is not supported by async+kafka servers")
+	})
+
+	t.Run("Delete resource", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.DeleteResourceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "resource-id", Value: resourceID},
+				&cli.BoolFlag{Name: "yes", Value: true},
+			},
+		})
+		assert.Nil(t, err, "Failed to delete resource")
+		assert.Contains(t, output, "deleted")
+	})
+
+	t.Run("Update and get server", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.UpdateServer, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "server-id", Value: serverID},
+				&cli.StringFlag{Name: "description", Value: "Renamed Kafka server"},
+				&cli.BoolFlag{Name: "yes", Value: true},
+			},
+		})
+		assert.Nil(t, err, "Failed to update server")
+
+		output, err := utils.CaptureOutputInTests(actions.GetServer, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "server-id", Value: serverID},
+			},
+		})
+		assert.Nil(t, err, "Failed to get server")
+
+		var server contracts.Server
+		err = json.Unmarshal([]byte(output), &server)
+		assert.Nil(t, err, "Failed to parse server response")
+		assert.Equal(t, "Renamed Kafka server", server.Description)
+	})
+
+	t.Run("Delete server", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.DeleteServer, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "server-id", Value: serverID},
+				&cli.BoolFlag{Name: "yes", Value: true},
+			},
+		})
+		assert.Nil(t, err, "Failed to delete server")
+		assert.Contains(t, output, "deleted")
+	})
 }
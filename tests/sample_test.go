@@ -0,0 +1,253 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/api"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func TestSampleSchemaAction(t *testing.T) {
+	// Load .env file
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+	projectName := "TestProjectForSamples"
+	var projectID string             // To store the ID of the created project
+	schemaIDs := map[string]string{} // To store the IDs of the created schemas by name
+
+	t.Run("Sign up", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: newUniqueEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			if err := os.Setenv("FC_ACCESS_TOKEN", token); err != nil {
+				t.Fatalf("Failed to store token in environment: %v", err)
+			}
+		} else {
+			t.Fatal("Signup did not return a token")
+		}
+	})
+
+	t.Run("Create a new project", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateNewProjectAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Value: projectName,
+				},
+				&cli.StringFlag{
+					Name:  "belongs-to",
+					Value: "user",
+				},
+				&cli.BoolFlag{
+					Name:  "private",
+					Value: true,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdProject api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &createdProject)
+		assert.Nil(t, err)
+		projectID = createdProject.ID
+		assert.NotEmpty(t, projectID, "Project ID should be set")
+	})
+
+	t.Run("Create schemas to sample", func(t *testing.T) {
+		testCases := []struct {
+			name       string
+			schemaFile string
+		}{
+			{
+				name:       "MeasurementSchema",
+				schemaFile: "testfiles/schemas/sampleRanges1.json",
+			},
+			{
+				name:       "RatioSchema",
+				schemaFile: "testfiles/schemas/sampleNoMultiple1.json",
+			},
+			{
+				name:       "LinkedNodeSchema",
+				schemaFile: "testfiles/schemas/sampleRecursive1.json",
+			},
+			{
+				name:       "EndlessNodeSchema",
+				schemaFile: "testfiles/schemas/sampleUnsatisfiable1.json",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				output, err := utils.CaptureOutputInTests(actions.CreateSchemaAction, context.Background(), &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "project-id",
+							Value: projectID,
+						},
+						&cli.StringFlag{
+							Name:  "name",
+							Value: tc.name,
+						},
+						&cli.StringFlag{
+							Name:  "type",
+							Value: "jsonschema",
+						},
+						&cli.StringFlag{
+							Name:  "schema-file",
+							Value: tc.schemaFile,
+						},
+					},
+				})
+				assert.Nil(t, err)
+
+				var createdSchema api.SchemaAPIResponse
+				err = json.Unmarshal([]byte(output), &createdSchema)
+				assert.Nil(t, err)
+				assert.NotEmpty(t, createdSchema.ID, "Schema ID should be set")
+				schemaIDs[tc.name] = createdSchema.ID
+			})
+		}
+	})
+
+	t.Run("Generate samples with numeric ranges beyond int64", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SampleSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaIDs["MeasurementSchema"],
+				},
+				&cli.IntFlag{
+					Name:  "count",
+					Value: 20,
+				},
+				&cli.IntFlag{
+					Name:  "seed",
+					Value: 42,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var samples []map[string]float64
+		err = json.Unmarshal([]byte(output), &samples)
+		assert.NoError(t, err)
+		assert.Len(t, samples, 20)
+		for _, sample := range samples {
+			assert.GreaterOrEqual(t, sample["bigCounter"], 1e19)
+			assert.LessOrEqual(t, sample["bigCounter"], 1e20)
+			assert.GreaterOrEqual(t, sample["reading"], -1.7e308)
+			assert.LessOrEqual(t, sample["reading"], 1.7e308)
+		}
+	})
+
+	t.Run("Generate samples without a multiple in range", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.SampleSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaIDs["RatioSchema"],
+				},
+				&cli.IntFlag{
+					Name:  "seed",
+					Value: 42,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to generate a document valid against the schema")
+	})
+
+	t.Run("Generate samples from a recursive schema", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SampleSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaIDs["LinkedNodeSchema"],
+				},
+				&cli.IntFlag{
+					Name:  "count",
+					Value: 5,
+				},
+				&cli.IntFlag{
+					Name:  "seed",
+					Value: 42,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var samples []map[string]interface{}
+		err = json.Unmarshal([]byte(output), &samples)
+		assert.NoError(t, err)
+		assert.Len(t, samples, 5)
+		for _, sample := range samples {
+			// Follow the chain of nodes, it has to end with null
+			node := sample
+			for node != nil {
+				assert.Contains(t, node, "value")
+				assert.Contains(t, node, "next")
+				next, _ := node["next"].(map[string]interface{})
+				node = next
+			}
+		}
+	})
+
+	t.Run("Generate samples from a schema which never ends", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.SampleSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaIDs["EndlessNodeSchema"],
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to generate sample")
+		assert.Contains(t, err.Error(), "the schema allows no value to end it")
+	})
+}
@@ -138,6 +138,121 @@ func TestSchemaActions(t *testing.T) {
 		assert.Equal(t, "Schema for a person with first name, last name, and age", createdSchema.Description)
 	})
 
+	t.Run("Create Avro schema with file", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "PersonAvroSchema",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Value: "avro",
+				},
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: "testfiles/schemas/validAvroSchema1.avsc",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdSchema api.SchemaAPIResponse
+		err = json.Unmarshal([]byte(output), &createdSchema)
+		assert.Nil(t, err)
+		assert.Equal(t, "PersonAvroSchema", createdSchema.Name)
+		assert.Equal(t, "avro", createdSchema.Type)
+	})
+
+	t.Run("Create Protobuf schema with file", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CreateSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "name",
+					Value: "PersonProtobufSchema",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Value: "protobuf",
+				},
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: "testfiles/schemas/validProtobufSchema1.proto",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		var createdSchema api.SchemaAPIResponse
+		err = json.Unmarshal([]byte(output), &createdSchema)
+		assert.Nil(t, err)
+		assert.Equal(t, "PersonProtobufSchema", createdSchema.Name)
+		assert.Equal(t, "protobuf", createdSchema.Type)
+	})
+
+	t.Run("Create schema with invalid content or type", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			schemaType    string
+			schemaFile    string
+			expectedError string
+		}{
+			{
+				name:          "invalid Avro schema",
+				schemaType:    "avro",
+				schemaFile:    "testfiles/schemas/invalidAvroSchema1.avsc",
+				expectedError: "invalid Avro schema",
+			},
+			{
+				name:          "JSON schema declared as Protobuf",
+				schemaType:    "protobuf",
+				schemaFile:    schemaFilePath,
+				expectedError: "invalid Protobuf schema",
+			},
+			{
+				name:          "unsupported schema type",
+				schemaType:    "xml",
+				schemaFile:    schemaFilePath,
+				expectedError: "unsupported schema type",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := utils.CaptureOutputInTests(actions.CreateSchemaAction, context.Background(), &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "project-id",
+							Value: projectID,
+						},
+						&cli.StringFlag{
+							Name:  "name",
+							Value: "InvalidSchema",
+						},
+						&cli.StringFlag{
+							Name:  "type",
+							Value: tc.schemaType,
+						},
+						&cli.StringFlag{
+							Name:  "schema-file",
+							Value: tc.schemaFile,
+						},
+					},
+				})
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			})
+		}
+	})
+
 	t.Run("List schemas after creation", func(t *testing.T) {
 		output, err := utils.CaptureOutputInTests(actions.ListSchemasAction, context.Background(), &cli.Command{
 			Flags: []cli.Flag{
@@ -152,10 +267,16 @@ func TestSchemaActions(t *testing.T) {
 		var schemas []api.SchemaAPIResponse
 		err = json.Unmarshal([]byte(output), &schemas)
 		assert.Nil(t, err)
-		assert.Len(t, schemas, 1, "Should return one schema")
+		assert.Len(t, schemas, 3, "Should return three schemas")
 
-		// Verify schema name
-		assert.Equal(t, "PersonSchema", schemas[0].Name)
+		// Verify schema names
+		var names []string
+		for _, schema := range schemas {
+			names = append(names, schema.Name)
+		}
+		assert.Contains(t, names, "PersonSchema")
+		assert.Contains(t, names, "PersonAvroSchema")
+		assert.Contains(t, names, "PersonProtobufSchema")
 	})
 
 	// Store schema ID for update tests
@@ -174,8 +295,11 @@ func TestSchemaActions(t *testing.T) {
 		var schemas []api.SchemaAPIResponse
 		err = json.Unmarshal([]byte(output), &schemas)
 		assert.Nil(t, err)
-		assert.Len(t, schemas, 1, "Should return one schema")
-		schemaID = schemas[0].ID
+		for _, schema := range schemas {
+			if schema.Name == "PersonSchema" {
+				schemaID = schema.ID
+			}
+		}
 		assert.NotEmpty(t, schemaID, "Schema ID should be set")
 	})
 
@@ -220,6 +344,10 @@ func TestSchemaActions(t *testing.T) {
 	t.Run("Update schema content", func(t *testing.T) {
 		output, err := utils.CaptureOutputInTests(actions.UpdateSchemaAction, context.Background(), &cli.Command{
 			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
 				&cli.StringFlag{
 					Name:  "schema-id",
 					Value: schemaID,
@@ -240,15 +368,78 @@ func TestSchemaActions(t *testing.T) {
 		assert.Equal(t, "Schema for a person with first name, last name, and age", updatedSchema.Description)
 	})
 
+	t.Run("Check compatibility of a compatible schema file", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CheckSchemaCompatibilityAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: tempFile.Name(),
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "\"compatible\": true")
+	})
+
+	t.Run("Check compatibility of an incompatible schema file", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.CheckSchemaCompatibilityAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-file",
+					Value: "testfiles/schemas/incompatibleSchema1.json",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Schema is not compatible")
+		assert.Contains(t, output, "property \\\"phone\\\" became required")
+		assert.Contains(t, output, "required property \\\"lastName\\\" was removed")
+		assert.Contains(t, output, "type changed from integer to string")
+	})
+
 	t.Run("Update schema with missing required fields", func(t *testing.T) {
 		testCases := []struct {
 			name          string
 			flags         []cli.Flag
 			expectedError string
 		}{
+			{
+				name: "missing project ID",
+				flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "schema-id",
+						Value: schemaID,
+					},
+					&cli.StringFlag{
+						Name:  "schema-file",
+						Value: tempFile.Name(),
+					},
+				},
+				expectedError: "Project ID is required",
+			},
 			{
 				name: "missing schema ID",
 				flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "project-id",
+						Value: projectID,
+					},
 					&cli.StringFlag{
 						Name:  "schema-file",
 						Value: tempFile.Name(),
@@ -259,6 +450,10 @@ func TestSchemaActions(t *testing.T) {
 			{
 				name: "missing schema file",
 				flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "project-id",
+						Value: projectID,
+					},
 					&cli.StringFlag{
 						Name:  "schema-id",
 						Value: schemaID,
@@ -461,6 +656,213 @@ func TestSchemaActions(t *testing.T) {
 		assert.NotEmpty(t, versionResponse.Schema)
 	})
 
+	t.Run("Get schema version by version number", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetSchemaVersionAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.IntFlag{
+					Name:  "version",
+					Value: 1,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var versionResponse api.SchemaVersionAPIResponse
+		err = json.Unmarshal([]byte(output), &versionResponse)
+		assert.NoError(t, err)
+		assert.Equal(t, schemaID, versionResponse.SchemaID)
+		assert.Equal(t, 1, versionResponse.Version)
+	})
+
+	t.Run("Get latest schema version", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetSchemaVersionAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.BoolFlag{
+					Name:  "latest",
+					Value: true,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var versionResponse api.SchemaVersionAPIResponse
+		err = json.Unmarshal([]byte(output), &versionResponse)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, versionResponse.Version)
+		assert.Contains(t, versionResponse.Schema, "email")
+	})
+
+	t.Run("Get only the schema body of the latest version", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetSchemaVersionAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.BoolFlag{
+					Name:  "latest",
+					Value: true,
+				},
+				&cli.BoolFlag{
+					Name:  "schema-only",
+					Value: true,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var schemaBody map[string]interface{}
+		err = json.Unmarshal([]byte(output), &schemaBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Person", schemaBody["title"])
+	})
+
+	t.Run("Get schema version with non-existing version number", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.GetSchemaVersionAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.IntFlag{
+					Name:  "version",
+					Value: 999,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "version 999 not found")
+	})
+
+	t.Run("Get schema version with version number zero", func(t *testing.T) {
+		cmd := &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.IntFlag{
+					Name: "version",
+				},
+			},
+		}
+		// --version 0 is given explicitly, it must not be taken for the latest version
+		assert.NoError(t, cmd.Set("version", "0"))
+
+		_, err := utils.CaptureOutputInTests(actions.GetSchemaVersionAction, context.Background(), cmd)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Version number must be a positive integer")
+	})
+
+	t.Run("Get schema version with conflicting selectors", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.GetSchemaVersionAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: schemaID,
+				},
+				&cli.IntFlag{
+					Name:  "version",
+					Value: 1,
+				},
+				&cli.BoolFlag{
+					Name:  "latest",
+					Value: true,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only one of --version-id, --version and --latest")
+	})
+
+	t.Run("Generate deterministic samples from a schema", func(t *testing.T) {
+		sampleFlags := []cli.Flag{
+			&cli.StringFlag{
+				Name:  "project-id",
+				Value: projectID,
+			},
+			&cli.StringFlag{
+				Name:  "schema-id",
+				Value: schemaID,
+			},
+			&cli.IntFlag{
+				Name:  "count",
+				Value: 3,
+			},
+			&cli.IntFlag{
+				Name:  "seed",
+				Value: 42,
+			},
+		}
+
+		firstOutput, err := utils.CaptureOutputInTests(actions.SampleSchemaAction, context.Background(), &cli.Command{
+			Flags: sampleFlags,
+		})
+		assert.NoError(t, err)
+
+		secondOutput, err := utils.CaptureOutputInTests(actions.SampleSchemaAction, context.Background(), &cli.Command{
+			Flags: sampleFlags,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, firstOutput, secondOutput, "Samples generated with the same seed should be equal")
+
+		var samples []map[string]interface{}
+		err = json.Unmarshal([]byte(firstOutput), &samples)
+		assert.NoError(t, err)
+		assert.Len(t, samples, 3)
+		for _, sample := range samples {
+			assert.Contains(t, sample, "firstName")
+			assert.Contains(t, sample, "lastName")
+			assert.Contains(t, sample["email"], "@")
+		}
+	})
+
+	t.Run("Generate samples from a Protobuf schema", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ListSchemasAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var schemas []api.SchemaAPIResponse
+		err = json.Unmarshal([]byte(output), &schemas)
+		assert.NoError(t, err)
+
+		var protobufSchemaID string
+		for _, schema := range schemas {
+			if schema.Type == "protobuf" {
+				protobufSchemaID = schema.ID
+			}
+		}
+
+		_, err = utils.CaptureOutputInTests(actions.SampleSchemaAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "project-id",
+					Value: projectID,
+				},
+				&cli.StringFlag{
+					Name:  "schema-id",
+					Value: protobufSchemaID,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Samples can only be generated for jsonschema schemas")
+	})
+
 	t.Run("Get version with missing required fields", func(t *testing.T) {
 		testCases := []struct {
 			name          string
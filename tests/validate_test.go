@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fusioncatalyst/paw/asyncapi"
+	"github.com/fusioncatalyst/paw/contracts"
+	"github.com/fusioncatalyst/paw/provision"
+	"github.com/fusioncatalyst/paw/schemas"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtobufValidatorMessageSelection(t *testing.T) {
+	content, err := os.ReadFile("testfiles/schemas/multiMessageProtobufSchema1.proto")
+	if err != nil {
+		t.Fatalf("Failed to read schema file: %v", err)
+	}
+	person := []byte(`{"firstName": "Ada", "lastName": "Lovelace", "address": {"city": "London"}}`)
+
+	t.Run("Schema with several messages requires a message name", func(t *testing.T) {
+		_, err := schemas.NewValidator(contracts.SchemaTypeProtobuf, string(content), "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "declares 2 messages (Address, Person)")
+	})
+
+	t.Run("Validate against a named message", func(t *testing.T) {
+		validator, err := schemas.NewValidator(contracts.SchemaTypeProtobuf, string(content), "Person")
+		assert.NoError(t, err)
+		assert.Empty(t, validator.Validate(person))
+
+		validator, err = schemas.NewValidator(contracts.SchemaTypeProtobuf, string(content), "example.Address")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, validator.Validate(person), "A person is not a valid address")
+	})
+
+	t.Run("Validate against an unknown message", func(t *testing.T) {
+		_, err := schemas.NewValidator(contracts.SchemaTypeProtobuf, string(content), "Company")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not declare a top-level message \"Company\"")
+	})
+}
+
+func TestCloudEventsRequireJSONSchema(t *testing.T) {
+	document := &contracts.ProvisionYAMLFile{
+		Version: 1,
+		Schemas: []contracts.ProvisionSchema{
+			{Name: "user", Type: contracts.SchemaTypeAvro, Schema: `{"type": "record", "name": "User", "fields": [{"name": "id", "type": "string"}]}`},
+		},
+		Messages: []contracts.ProvisionMessage{
+			{Name: "UserCreated", Schema: contracts.ProvisionMessageSchema{Name: "user"}, CloudEvents: &contracts.CloudEventAttributes{Type: "UserCreated"}},
+		},
+	}
+
+	t.Run("Validation rejects CloudEvents messages with an Avro schema", func(t *testing.T) {
+		errs := provision.Validate(document)
+		if assert.Len(t, errs, 1) {
+			assert.Contains(t, errs[0].Error(), "cloudevents is only supported for jsonschema schemas")
+		}
+	})
+
+	t.Run("Export refuses CloudEvents messages with an Avro schema", func(t *testing.T) {
+		_, err := asyncapi.Export(document, asyncapi.Options{Title: "users"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported for jsonschema schemas")
+	})
+}
@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusioncatalyst/paw/actions"
+	"github.com/fusioncatalyst/paw/utils"
+	"github.com/fusioncatalyst/paw/watch"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+func TestWatcher(t *testing.T) {
+	// Load .env file
+	if err := godotenv.Load(".env"); err != nil {
+		t.Fatalf("Error loading .env file: %v", err)
+	}
+
+	currentTimestamp := strconv.FormatInt(time.Now().UnixNano(), 10)
+	newUniqueEmail := fmt.Sprintf("testmail%s@testmail.com", currentTimestamp)
+	testPassword := "password123"
+	watcher := watch.Watcher{
+		Interval: 10 * time.Millisecond,
+		Debounce: 150 * time.Millisecond,
+	}
+
+	// waitResult is what Wait returned and when
+	type waitResult struct {
+		files    []string
+		err      error
+		returned time.Time
+	}
+	startWait := func(ctx context.Context, files []string, since watch.Snapshot) <-chan waitResult {
+		results := make(chan waitResult, 1)
+		go func() {
+			changed, err := watcher.Wait(ctx, files, since)
+			results <- waitResult{files: changed, err: err, returned: time.Now()}
+		}()
+		return results
+	}
+	writeFile := func(t *testing.T, path, content string) time.Time {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return time.Now()
+	}
+
+	t.Run("Sign up", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: newUniqueEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		token := strings.TrimSpace(string(output))
+		if token != "" {
+			if err := os.Setenv("FC_ACCESS_TOKEN", token); err != nil {
+				t.Fatalf("Failed to store token in environment: %v", err)
+			}
+		} else {
+			t.Fatal("Signup did not return a token")
+		}
+	})
+
+	t.Run("Report a changed file after the debounce time", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "project.yaml")
+		writeFile(t, file, "version: 1\n")
+		files := []string{file}
+
+		results := startWait(context.Background(), files, watch.Take(files))
+		time.Sleep(50 * time.Millisecond)
+		changedAt := writeFile(t, file, "version: 1\nservers: []\n")
+
+		result := <-results
+		require.NoError(t, result.err)
+		assert.Equal(t, files, result.files)
+		assert.GreaterOrEqual(t, result.returned.Sub(changedAt), watcher.Debounce, "The change should only be reported once the file stayed unchanged")
+	})
+
+	t.Run("Report files saved in quick succession once", func(t *testing.T) {
+		directory := t.TempDir()
+		first := filepath.Join(directory, "a.yaml")
+		second := filepath.Join(directory, "b.yaml")
+		unchanged := filepath.Join(directory, "c.yaml")
+		writeFile(t, first, "a")
+		writeFile(t, second, "b")
+		writeFile(t, unchanged, "c")
+		files := []string{unchanged, second, first}
+
+		results := startWait(context.Background(), files, watch.Take(files))
+		time.Sleep(50 * time.Millisecond)
+		writeFile(t, second, "bb")
+		time.Sleep(50 * time.Millisecond)
+		lastChange := writeFile(t, first, "aa")
+
+		result := <-results
+		require.NoError(t, result.err)
+		assert.Equal(t, []string{first, second}, result.files, "All changed files should be reported together, sorted")
+		assert.GreaterOrEqual(t, result.returned.Sub(lastChange), watcher.Debounce)
+	})
+
+	t.Run("Keep waiting while a file keeps changing", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "project.yaml")
+		writeFile(t, file, "")
+		files := []string{file}
+
+		results := startWait(context.Background(), files, watch.Take(files))
+
+		// Change the file more often than the debounce time for three debounce periods
+		var lastChange time.Time
+		for i := 1; i <= 9; i++ {
+			time.Sleep(watcher.Debounce / 3)
+			select {
+			case result := <-results:
+				t.Fatalf("Wait returned %v while the file was still changing", result.files)
+			default:
+			}
+			lastChange = writeFile(t, file, strings.Repeat("x", i))
+		}
+
+		result := <-results
+		require.NoError(t, result.err)
+		assert.Equal(t, files, result.files)
+		assert.GreaterOrEqual(t, result.returned.Sub(lastChange), watcher.Debounce)
+	})
+
+	t.Run("Report created and deleted files", func(t *testing.T) {
+		directory := t.TempDir()
+		created := filepath.Join(directory, "created.yaml")
+		deleted := filepath.Join(directory, "deleted.yaml")
+		writeFile(t, deleted, "version: 1\n")
+		files := []string{created, deleted}
+
+		results := startWait(context.Background(), files, watch.Take(files))
+		time.Sleep(50 * time.Millisecond)
+		writeFile(t, created, "version: 1\n")
+		require.NoError(t, os.Remove(deleted))
+
+		result := <-results
+		require.NoError(t, result.err)
+		assert.Equal(t, []string{created, deleted}, result.files)
+	})
+
+	t.Run("Report changes made before waiting started", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "project.yaml")
+		writeFile(t, file, "version: 1\n")
+		files := []string{file}
+
+		// The snapshot is taken before the file is saved again, e.g. while a project is applied
+		snapshot := watch.Take(files)
+		writeFile(t, file, "version: 1\nservers: []\n")
+
+		result := <-startWait(context.Background(), files, snapshot)
+		require.NoError(t, result.err)
+		assert.Equal(t, files, result.files)
+	})
+
+	t.Run("Compare files missing from the snapshot with their state when waiting started", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "included.yaml")
+		writeFile(t, file, "servers: []\n")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*watcher.Debounce)
+		defer cancel()
+		result := <-startWait(ctx, []string{file}, watch.Snapshot{})
+		assert.ErrorIs(t, result.err, context.DeadlineExceeded, "An unchanged file should not be reported")
+		assert.Nil(t, result.files)
+	})
+
+	t.Run("Stop waiting when the context is cancelled", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "project.yaml")
+		writeFile(t, file, "version: 1\n")
+		files := []string{file}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		results := startWait(ctx, files, watch.Take(files))
+
+		// A change which is still being debounced is dropped
+		time.Sleep(50 * time.Millisecond)
+		writeFile(t, file, "version: 1\nservers: []\n")
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+
+		result := <-results
+		assert.ErrorIs(t, result.err, context.Canceled)
+		assert.Nil(t, result.files)
+	})
+}
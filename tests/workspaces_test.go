@@ -15,6 +15,7 @@ import (
 	"github.com/fusioncatalyst/paw/utils"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v3"
 )
 
@@ -30,6 +31,7 @@ func TestWorkspaceActions(t *testing.T) {
 	workspaceName1 := "testworkspace1"
 	workspaceName2 := "testworkspace2"
 	var workspaceID1 string
+	memberEmail := fmt.Sprintf("testmember%s@testmail.com", currentTimestamp)
 
 	t.Run("Sign up", func(t *testing.T) {
 		output, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
@@ -97,7 +99,7 @@ func TestWorkspaceActions(t *testing.T) {
 		var workspaces []api.UserWorkspaceAPIResponse
 		err = json.Unmarshal([]byte(output), &workspaces)
 		assert.NoError(t, err)
-		assert.Len(t, workspaces, 1, "Should return one workspace")
+		require.Len(t, workspaces, 1, "Should return one workspace")
 		assert.Equal(t, workspaceID1, workspaces[0].Workspace.ID)
 		assert.Equal(t, workspaceName1, workspaces[0].Workspace.Name)
 	})
@@ -146,4 +148,294 @@ func TestWorkspaceActions(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "Workspace name is required")
 	})
+
+	t.Run("Get workspace", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.GetWorkspaceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var workspace api.WorkspaceAPIResponse
+		err = json.Unmarshal([]byte(output), &workspace)
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID1, workspace.ID)
+		assert.Equal(t, workspaceName1, workspace.Name)
+	})
+
+	t.Run("Update workspace", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.UpdateWorkspaceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "description",
+					Value: "Updated description.",
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var workspace api.WorkspaceAPIResponse
+		err = json.Unmarshal([]byte(output), &workspace)
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceName1, workspace.Name)
+		assert.Equal(t, "Updated description.", workspace.Description)
+	})
+
+	t.Run("Update workspace without changes", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.UpdateWorkspaceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Nothing to update")
+	})
+
+	t.Run("List projects in workspace", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ListWorkspaceProjectsAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var projects []api.ProjectAPIResponse
+		err = json.Unmarshal([]byte(output), &projects)
+		assert.NoError(t, err)
+		assert.Empty(t, projects, "Should return an empty list for a new workspace")
+	})
+
+	t.Run("List workspace members", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ListWorkspaceMembersAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var members []api.WorkspaceMemberAPIResponse
+		err = json.Unmarshal([]byte(output), &members)
+		assert.NoError(t, err)
+		require.Len(t, members, 1, "Should return the creator of the workspace")
+		assert.Equal(t, newUniqueEmail, members[0].Email)
+		assert.Equal(t, "owner", members[0].Role)
+	})
+
+	t.Run("Sign up second user", func(t *testing.T) {
+		// The token of the second user is discarded, the first user stays logged in
+		_, err := utils.CaptureOutputInTests(actions.SignUpAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: memberEmail,
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: testPassword,
+				},
+			},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Add workspace member with invalid role", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.AddWorkspaceMemberAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "email",
+					Value: memberEmail,
+				},
+				&cli.StringFlag{
+					Name:  "role",
+					Value: "superuser",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid role: superuser")
+	})
+
+	t.Run("Add workspace member", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.AddWorkspaceMemberAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "email",
+					Value: memberEmail,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var member api.WorkspaceMemberAPIResponse
+		err = json.Unmarshal([]byte(output), &member)
+		assert.NoError(t, err)
+		assert.Equal(t, memberEmail, member.Email)
+		assert.Equal(t, "member", member.Role)
+	})
+
+	t.Run("Set role of workspace member", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.SetWorkspaceMemberRoleAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "email",
+					Value: memberEmail,
+				},
+				&cli.StringFlag{
+					Name:  "role",
+					Value: "admin",
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var member api.WorkspaceMemberAPIResponse
+		err = json.Unmarshal([]byte(output), &member)
+		assert.NoError(t, err)
+		assert.Equal(t, memberEmail, member.Email)
+		assert.Equal(t, "admin", member.Role)
+	})
+
+	t.Run("Remove workspace member", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.RemoveWorkspaceMemberAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "email",
+					Value: memberEmail,
+				},
+				&cli.BoolFlag{
+					Name:  "yes",
+					Value: true,
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, output, fmt.Sprintf("Removed %s from the workspace", memberEmail))
+	})
+
+	t.Run("Remove user who is not a member", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.RemoveWorkspaceMemberAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "email",
+					Value: memberEmail,
+				},
+				&cli.BoolFlag{
+					Name:  "yes",
+					Value: true,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not a member of workspace")
+	})
+
+	t.Run("Invite to workspace", func(t *testing.T) {
+		invitedEmail := fmt.Sprintf("testinvite%s@testmail.com", currentTimestamp)
+		output, err := utils.CaptureOutputInTests(actions.InviteToWorkspaceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "email",
+					Value: invitedEmail,
+				},
+				&cli.StringFlag{
+					Name:  "role",
+					Value: "admin",
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var invitation api.WorkspaceInvitationAPIResponse
+		err = json.Unmarshal([]byte(output), &invitation)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, invitation.ID, "Invitation ID should be set")
+		assert.Equal(t, invitedEmail, invitation.Email)
+		assert.Equal(t, "admin", invitation.Role)
+	})
+
+	t.Run("Delete workspace with wrong name", func(t *testing.T) {
+		_, err := utils.CaptureOutputInTests(actions.DeleteWorkspaceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "confirm",
+					Value: workspaceName2,
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Aborted, the workspace name does not match")
+	})
+
+	t.Run("Delete workspace", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.DeleteWorkspaceAction, context.Background(), &cli.Command{
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "workspace-id",
+					Value: workspaceID1,
+				},
+				&cli.StringFlag{
+					Name:  "confirm",
+					Value: workspaceName1,
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, output, fmt.Sprintf("Workspace %s deleted", workspaceName1))
+	})
+
+	t.Run("List workspaces after deleting one", func(t *testing.T) {
+		output, err := utils.CaptureOutputInTests(actions.ListWorkspacesAction, context.Background(), &cli.Command{})
+		assert.NoError(t, err)
+
+		var workspaces []api.UserWorkspaceAPIResponse
+		err = json.Unmarshal([]byte(output), &workspaces)
+		assert.NoError(t, err)
+		require.Len(t, workspaces, 1, "Should return one workspace")
+		assert.Equal(t, workspaceName2, workspaces[0].Workspace.Name)
+	})
 }
@@ -0,0 +1,88 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"sort"
+	"time"
+)
+
+// Snapshot records the state of watched files, missing files are recorded as absent
+type Snapshot map[string]fileState
+
+type fileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+// Take records the current state of files
+func Take(files []string) Snapshot {
+	snapshot := make(Snapshot, len(files))
+	for _, file := range files {
+		snapshot[file] = stat(file)
+	}
+	return snapshot
+}
+
+func stat(file string) fileState {
+	info, err := os.Stat(file)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{exists: true, modTime: info.ModTime(), size: info.Size()}
+}
+
+// Watcher polls files for changes. Polling keeps the CLI free of platform specific
+// notification APIs and also works for editors which save by replacing files.
+type Watcher struct {
+	// Interval is the time between two polls
+	Interval time.Duration
+	// Debounce is how long files must stay unchanged before a change is reported, so that
+	// saving several files at once results in a single change
+	Debounce time.Duration
+}
+
+// Wait blocks until any of files differs from since and then stays unchanged for the debounce
+// time, and returns the changed files. Files which are not part of since are compared with
+// their state at the time Wait is called.
+func (w Watcher) Wait(ctx context.Context, files []string, since Snapshot) ([]string, error) {
+	last := make(Snapshot, len(files))
+	for _, file := range files {
+		if state, ok := since[file]; ok {
+			last[file] = state
+		} else {
+			last[file] = stat(file)
+		}
+	}
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	changed := map[string]bool{}
+	var lastChange time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case now := <-ticker.C:
+			current := Take(files)
+			for file, state := range current {
+				if state != last[file] {
+					changed[file] = true
+					lastChange = now
+				}
+			}
+			last = current
+
+			if len(changed) > 0 && now.Sub(lastChange) >= w.Debounce {
+				files := make([]string, 0, len(changed))
+				for file := range changed {
+					files = append(files, file)
+				}
+				sort.Strings(files)
+				return files, nil
+			}
+		}
+	}
+}